@@ -1,6 +1,10 @@
 package geom
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
 
 // AABB is a minimal axis-aligned rectangle defined by its top-left and bottom-right corners.
 type AABB[T Numeric] struct {
@@ -38,27 +42,104 @@ func (ab AABB[T]) String() string {
 	return fmt.Sprintf("{%v %v}", ab.TopLeft, ab.BottomRight)
 }
 
-// Split divides the box into four equal quadrants around its center.
+// Split divides the box into four quadrants around its center. With odd integer
+// sizes the right and bottom quadrants take the extra unit, so the quadrants
+// always cover the box exactly.
 func (ab AABB[T]) Split() [4]AABB[T] {
-	w := ab.BottomRight.X - ab.TopLeft.X
-	h := ab.BottomRight.Y - ab.TopLeft.Y
-	hw := w / 2
-	hh := h / 2
+	return ab.SplitAt(NewVec(
+		splitEdge(ab.TopLeft.X, ab.BottomRight.X, 1, 2),
+		splitEdge(ab.TopLeft.Y, ab.BottomRight.Y, 1, 2),
+	))
+}
 
-	midX := ab.TopLeft.X + hw
-	midY := ab.TopLeft.Y + hh
+// SplitAt divides the box into four quadrants meeting at point, in the order
+// top-left, top-right, bottom-left, bottom-right. A point outside the box is
+// clamped onto it, which leaves some quadrants with zero width or height.
+func (ab AABB[T]) SplitAt(point Vec[T]) [4]AABB[T] {
+	tl, br := ab.TopLeft, ab.BottomRight
+	x := min(max(point.X, tl.X), br.X)
+	y := min(max(point.Y, tl.Y), br.Y)
+	return [4]AABB[T]{
+		NewAABB(tl, NewVec(x, y)),
+		NewAABB(NewVec(x, tl.Y), NewVec(br.X, y)),
+		NewAABB(NewVec(tl.X, y), NewVec(x, br.Y)),
+		NewAABB(NewVec(x, y), br),
+	}
+}
+
+// SplitGrid divides the box into cols x rows tiles listed row by row. Integer
+// remainders are spread over the tiles, so tile sizes differ by at most one
+// unit and the tiles cover the box exactly. It returns nil when cols or rows is
+// not positive.
+func (ab AABB[T]) SplitGrid(cols, rows int) []AABB[T] {
+	if cols <= 0 || rows <= 0 {
+		return nil
+	}
+	tiles := make([]AABB[T], 0, cols*rows)
+	for r := range rows {
+		y0 := splitEdge(ab.TopLeft.Y, ab.BottomRight.Y, r, rows)
+		y1 := splitEdge(ab.TopLeft.Y, ab.BottomRight.Y, r+1, rows)
+		for c := range cols {
+			x0 := splitEdge(ab.TopLeft.X, ab.BottomRight.X, c, cols)
+			x1 := splitEdge(ab.TopLeft.X, ab.BottomRight.X, c+1, cols)
+			tiles = append(tiles, NewAABB(NewVec(x0, y0), NewVec(x1, y1)))
+		}
+	}
+	return tiles
+}
 
-	topLeft := ab.TopLeft
-	topRightPos := NewVec(midX, ab.TopLeft.Y)
-	botLeftPos := NewVec(ab.TopLeft.X, midY)
-	botRightPos := NewVec(midX, midY)
+// SplitN divides the box into exactly n tiles of near-equal area, listed row
+// by row. The row count follows the box's aspect ratio so tiles stay close to
+// square; when n does not fill the rows evenly, the first rows get one tile
+// more and are made taller to match. It returns nil when n is not positive.
+func (ab AABB[T]) SplitN(n int) []AABB[T] {
+	if n <= 0 {
+		return nil
+	}
+	w := math.Abs(signedFloat64(ab.BottomRight.X - ab.TopLeft.X))
+	h := math.Abs(signedFloat64(ab.BottomRight.Y - ab.TopLeft.Y))
+	rows := 1
+	if w > 0 {
+		rows = min(max(int(math.Round(math.Sqrt(float64(n)*h/w))), 1), n)
+	} else if h > 0 {
+		rows = n
+	}
 
-	return [4]AABB[T]{
-		NewAABBAt(topLeft, w/2, h/2),
-		NewAABBAt(topRightPos, w/2, h/2),
-		NewAABBAt(botLeftPos, w/2, h/2),
-		NewAABBAt(botRightPos, w/2, h/2),
+	tiles := make([]AABB[T], 0, n)
+	done := 0
+	for r := range rows {
+		cols := n / rows
+		if r < n%rows {
+			cols++
+		}
+		// wysokość wiersza proporcjonalna do liczby kafelków, żeby pola były równe
+		y0 := splitEdge(ab.TopLeft.Y, ab.BottomRight.Y, done, n)
+		y1 := splitEdge(ab.TopLeft.Y, ab.BottomRight.Y, done+cols, n)
+		for c := range cols {
+			x0 := splitEdge(ab.TopLeft.X, ab.BottomRight.X, c, cols)
+			x1 := splitEdge(ab.TopLeft.X, ab.BottomRight.X, c+1, cols)
+			tiles = append(tiles, NewAABB(NewVec(x0, y0), NewVec(x1, y1)))
+		}
+		done += cols
 	}
+	return tiles
+}
+
+// splitEdge returns the i-th of n+1 edges dividing [lo, hi]. Integer offsets
+// are floor((hi-lo)*i/n) computed with a 128-bit product, so nothing overflows
+// and the last edge is exactly hi.
+func splitEdge[T Numeric](lo, hi T, i, n int) T {
+	switch {
+	case i <= 0:
+		return lo
+	case i >= n:
+		return hi
+	case isFloating[T]():
+		return lo + T(float64(hi-lo)*float64(i)/float64(n))
+	}
+	pHi, pLo := bits.Mul64(uint64(hi-lo), uint64(i))
+	q, _ := bits.Div64(pHi, pLo, uint64(n))
+	return lo + T(q)
 }
 
 // Contains reports whether other lies entirely within axis-aligned bounding box.
@@ -115,6 +196,51 @@ func Intersection[T Numeric](a, b AABB[T]) (AABB[T], bool) {
 	return NewAABB(NewVec(minX, minY), NewVec(maxX, maxY)), true
 }
 
+// Penetration describes how far one box has sunk into another.
+type Penetration[T Numeric] struct {
+	// Depth is, per axis, the shortest distance the first box must move along
+	// that axis alone to stop overlapping the second.
+	Depth Vec[T]
+	// MTV is the minimum translation vector: the shorter of the two per-axis
+	// pushes, signed, so that translating the first box by it leaves the boxes
+	// touching. Ties prefer the X axis.
+	MTV Vec[T]
+}
+
+// PenetrationAABB returns how a overlaps b and the push that separates them.
+// The boolean is false when the boxes do not overlap with positive area;
+// touching edges are not a penetration.
+func PenetrationAABB[T Numeric](a, b AABB[T]) (Penetration[T], bool) {
+	aMin, aMax := signedFloat64Vec(a.TopLeft), signedFloat64Vec(a.BottomRight)
+	bMin, bMax := signedFloat64Vec(b.TopLeft), signedFloat64Vec(b.BottomRight)
+	px, okX := penetration1D(aMin.X, aMax.X, bMin.X, bMax.X)
+	py, okY := penetration1D(aMin.Y, aMax.Y, bMin.Y, bMax.Y)
+	if !okX || !okY {
+		return Penetration[T]{}, false
+	}
+
+	p := Penetration[T]{Depth: roundToVec[T](Vec[float64]{math.Abs(px), math.Abs(py)})}
+	if math.Abs(px) <= math.Abs(py) {
+		p.MTV = roundToVec[T](Vec[float64]{X: px})
+	} else {
+		p.MTV = roundToVec[T](Vec[float64]{Y: py})
+	}
+	return p, true
+}
+
+// penetration1D returns the signed shortest push of [aMin, aMax] out of
+// [bMin, bMax], preferring the positive direction on ties.
+func penetration1D(aMin, aMax, bMin, bMax float64) (float64, bool) {
+	if aMax <= bMin || bMax <= aMin {
+		return 0, false
+	}
+	left, right := aMax-bMin, bMax-aMin
+	if left < right {
+		return -left, true
+	}
+	return right, true
+}
+
 // AxisDistanceTo returns the gap between tow given AABBs on the axis selected by axisValue.
 func (ab AABB[T]) AxisDistanceX(other AABB[T]) T {
 	return axisDistance1D(ab.TopLeft.X, ab.BottomRight.X, other.TopLeft.X, other.BottomRight.X)
@@ -1,6 +1,9 @@
 package geom
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestAABB_Split(t *testing.T) {
 	runAABBSplitTest[int](t, "int")
@@ -28,6 +31,102 @@ func runAABBSplitTest[T Numeric](t *testing.T, name string) {
 	})
 }
 
+func TestAABB_SplitCoversParent(t *testing.T) {
+	runAABBSplitCoversParentTest[int](t, "int")
+	runAABBSplitCoversParentTest[int16](t, "int16")
+	runAABBSplitCoversParentTest[uint8](t, "uint8")
+	runAABBSplitCoversParentTest[uint32](t, "uint32")
+	runAABBSplitCoversParentTest[Fixed](t, "Fixed")
+	runAABBSplitCoversParentTest[float32](t, "float32")
+	runAABBSplitCoversParentTest[float64](t, "float64")
+}
+
+func runAABBSplitCoversParentTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		// nieparzyste wymiary – stary Split gubił resztę
+		parent := NewAABB(NewVec(T(1), T(2)), NewVec(T(12), T(9)))
+		quads := parent.Split()
+
+		testCases := []struct {
+			name  string
+			tiles []AABB[T]
+			count int
+		}{
+			{name: "Split", tiles: quads[:], count: 4},
+			{name: "SplitAtOutside", tiles: func() []AABB[T] { q := parent.SplitAt(NewVec(T(4), T(100))); return q[:] }(), count: 4},
+			{name: "SplitGrid3x2", tiles: parent.SplitGrid(3, 2), count: 6},
+			{name: "SplitGrid7x1", tiles: parent.SplitGrid(7, 1), count: 7},
+			{name: "SplitN5", tiles: parent.SplitN(5), count: 5},
+			{name: "SplitN11", tiles: parent.SplitN(11), count: 11},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if len(tc.tiles) != tc.count {
+					t.Fatalf("expected %d tiles, got %d", tc.count, len(tc.tiles))
+				}
+				expectTiling(t, parent, tc.tiles)
+			})
+		}
+	})
+}
+
+func TestAABB_SplitGrid(t *testing.T) {
+	runAABBSplitGridTest[int](t, "int")
+	runAABBSplitGridTest[uint32](t, "uint32")
+	runAABBSplitGridTest[float64](t, "float64")
+}
+
+func runAABBSplitGridTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		parent := NewAABB(NewVec(T(0), T(0)), NewVec(T(12), T(6)))
+		tiles := parent.SplitGrid(3, 2)
+		expected := []AABB[T]{
+			NewAABBAt(NewVec(T(0), T(0)), T(4), T(3)),
+			NewAABBAt(NewVec(T(4), T(0)), T(4), T(3)),
+			NewAABBAt(NewVec(T(8), T(0)), T(4), T(3)),
+			NewAABBAt(NewVec(T(0), T(3)), T(4), T(3)),
+			NewAABBAt(NewVec(T(4), T(3)), T(4), T(3)),
+			NewAABBAt(NewVec(T(8), T(3)), T(4), T(3)),
+		}
+		for i := range expected {
+			if !tiles[i].Equals(expected[i]) {
+				t.Errorf("tile %d: expected %v, got %v", i, expected[i], tiles[i])
+			}
+		}
+
+		if got := parent.SplitGrid(0, 2); got != nil {
+			t.Errorf("expected nil for zero columns, got %v", got)
+		}
+		if got := parent.SplitN(0); got != nil {
+			t.Errorf("expected nil for zero tiles, got %v", got)
+		}
+	})
+}
+
+// expectTiling checks that tiles lie inside parent, do not overlap and add up
+// to its area.
+func expectTiling[T Numeric](t *testing.T, parent AABB[T], tiles []AABB[T]) {
+	t.Helper()
+	area := func(ab AABB[T]) float64 {
+		return signedFloat64(ab.BottomRight.X-ab.TopLeft.X) * signedFloat64(ab.BottomRight.Y-ab.TopLeft.Y)
+	}
+	sum := 0.0
+	for i, tile := range tiles {
+		if !parent.Contains(tile) {
+			t.Errorf("tile %v lies outside %v", tile, parent)
+		}
+		for _, other := range tiles[i+1:] {
+			if _, ok := PenetrationAABB(tile, other); ok {
+				t.Errorf("tiles %v and %v overlap", tile, other)
+			}
+		}
+		sum += area(tile)
+	}
+	if math.Abs(sum-area(parent)) > 1e-3 {
+		t.Errorf("expected tiles to cover area %v, got %v", area(parent), sum)
+	}
+}
+
 func TestAABB_NewAABBAround(t *testing.T) {
 	runAABBAroundTest[int](t, "int")
 	runAABBAroundTest[uint32](t, "uint32")
@@ -186,3 +285,45 @@ func runSortAABBsByTest[T Numeric](t *testing.T, name string) {
 		}
 	})
 }
+
+func TestAABB_Penetration(t *testing.T) {
+	runAABBPenetrationTest[int](t, "int")
+	runAABBPenetrationTest[uint32](t, "uint32")
+	runAABBPenetrationTest[float64](t, "float64")
+}
+
+func runAABBPenetrationTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		minusTwo, minusThree, minusFive := int32(-2), int32(-3), int32(-5)
+		box := func(x, y, w, h T) AABB[T] { return NewAABBAt(v(x, y), w, h) }
+		b := box(10, 10, 10, 10)
+
+		testCases := []struct {
+			name  string
+			a     AABB[T]
+			ok    bool
+			depth Vec[T]
+			mtv   Vec[T]
+		}{
+			{name: "fromLeft", a: box(8, 12, 4, 4), ok: true, depth: v(2, 6), mtv: v(T(minusTwo), 0)},
+			{name: "fromBelow", a: box(12, 17, 4, 4), ok: true, depth: v(6, 3), mtv: v(0, 3)},
+			{name: "fromAbove", a: box(11, 7, 8, 6), ok: true, depth: v(9, 3), mtv: v(0, T(minusThree))},
+			{name: "inside", a: box(11, 12, 4, 4), ok: true, depth: v(5, 6), mtv: v(T(minusFive), 0)},
+			{name: "tieprefersX", a: box(18, 18, 4, 4), ok: true, depth: v(2, 2), mtv: v(2, 0)},
+			{name: "touching", a: box(20, 10, 4, 4)},
+			{name: "apart", a: box(30, 30, 4, 4)},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				p, ok := PenetrationAABB(tc.a, b)
+				if ok != tc.ok {
+					t.Fatalf("expected ok=%v, got %v", tc.ok, ok)
+				}
+				if ok && (p.Depth != tc.depth || p.MTV != tc.mtv) {
+					t.Errorf("expected depth %v mtv %v, got %v %v", tc.depth, tc.mtv, p.Depth, p.MTV)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Affine is a 2D affine transform stored as the top two rows of a 3x3 matrix:
+//
+//	| A C E |     x' = A*x + C*y + E
+//	| B D F |     y' = B*x + D*y + F
+//	| 0 0 1 |
+//
+// Coefficients are float64 whatever T is, so integer spaces can still scale and
+// rotate; T only fixes the type of the vectors and boxes being transformed.
+// Integer results are rounded to the nearest grid point, unsigned components are
+// read as signed like UnsignedIntVectorMath does, and Fixed uses its real value.
+type Affine[T Numeric] struct {
+	A, B, C, D, E, F float64
+}
+
+// NewAffineIdentity returns the transform that leaves every vector unchanged.
+func NewAffineIdentity[T Numeric]() Affine[T] {
+	return Affine[T]{A: 1, D: 1}
+}
+
+// NewAffineTranslate returns the transform that moves vectors by offset.
+func NewAffineTranslate[T Numeric](offset Vec[T]) Affine[T] {
+	o := signedFloat64Vec(offset)
+	return Affine[T]{A: 1, D: 1, E: o.X, F: o.Y}
+}
+
+// NewAffineScale returns the transform that scales X by sx and Y by sy around the origin.
+func NewAffineScale[T Numeric](sx, sy float64) Affine[T] {
+	return Affine[T]{A: sx, D: sy}
+}
+
+// NewAffineRotate returns the transform that turns vectors around the origin by
+// angle radians, from +X towards +Y like Vec.Rotate.
+func NewAffineRotate[T Numeric](angle float64) Affine[T] {
+	sin, cos := math.Sincos(angle)
+	return Affine[T]{A: cos, B: sin, C: -sin, D: cos}
+}
+
+// NewAffineShear returns the transform x' = x + shx*y, y' = shy*x + y.
+func NewAffineShear[T Numeric](shx, shy float64) Affine[T] {
+	return Affine[T]{A: 1, B: shy, C: shx, D: 1}
+}
+
+// String formats the transform as "[A C E; B D F]".
+func (m Affine[T]) String() string {
+	return fmt.Sprintf("[%v %v %v; %v %v %v]", m.A, m.C, m.E, m.B, m.D, m.F)
+}
+
+// Then returns the transform that applies m first and next afterwards.
+func (m Affine[T]) Then(next Affine[T]) Affine[T] {
+	return Affine[T]{
+		A: next.A*m.A + next.C*m.B,
+		B: next.B*m.A + next.D*m.B,
+		C: next.A*m.C + next.C*m.D,
+		D: next.B*m.C + next.D*m.D,
+		E: next.A*m.E + next.C*m.F + next.E,
+		F: next.B*m.E + next.D*m.F + next.F,
+	}
+}
+
+// Translate returns m followed by a move by offset.
+func (m Affine[T]) Translate(offset Vec[T]) Affine[T] { return m.Then(NewAffineTranslate(offset)) }
+
+// Scale returns m followed by scaling around the origin.
+func (m Affine[T]) Scale(sx, sy float64) Affine[T] { return m.Then(NewAffineScale[T](sx, sy)) }
+
+// Rotate returns m followed by a rotation around the origin.
+func (m Affine[T]) Rotate(angle float64) Affine[T] { return m.Then(NewAffineRotate[T](angle)) }
+
+// Shear returns m followed by a shear.
+func (m Affine[T]) Shear(shx, shy float64) Affine[T] { return m.Then(NewAffineShear[T](shx, shy)) }
+
+// Determinant returns the area scale factor of m; it is negative when m mirrors.
+func (m Affine[T]) Determinant() float64 { return m.A*m.D - m.B*m.C }
+
+// Inverse returns the transform undoing m, or false when m is singular. The
+// singularity test is relative to the largest linear entry, so a uniform 1e-5
+// zoom still inverts; an inverse that is not finite is rejected as well.
+func (m Affine[T]) Inverse() (Affine[T], bool) {
+	det := m.Determinant()
+	scale := max(math.Abs(m.A), math.Abs(m.B), math.Abs(m.C), math.Abs(m.D))
+	if det == 0 || math.Abs(det) <= eps*scale*scale {
+		return Affine[T]{}, false
+	}
+	inv := Affine[T]{
+		A: m.D / det,
+		B: -m.B / det,
+		C: -m.C / det,
+		D: m.A / det,
+		E: (m.C*m.F - m.D*m.E) / det,
+		F: (m.B*m.E - m.A*m.F) / det,
+	}
+	for _, f := range [6]float64{inv.A, inv.B, inv.C, inv.D, inv.E, inv.F} {
+		if !isFiniteFloat64(f) {
+			return Affine[T]{}, false
+		}
+	}
+	return inv, true
+}
+
+// Apply transforms the point v, translation included.
+func (m Affine[T]) Apply(v Vec[T]) Vec[T] {
+	return roundToVec[T](m.applyFloat64(signedFloat64Vec(v)))
+}
+
+// TransformAABB returns the tightest box containing all four transformed corners
+// of ab. Integer bounds are rounded outwards so the box never loses coverage.
+func (m Affine[T]) TransformAABB(ab AABB[T]) AABB[T] {
+	tl, br := signedFloat64Vec(ab.TopLeft), signedFloat64Vec(ab.BottomRight)
+	corners := [4]Vec[float64]{tl, {br.X, tl.Y}, br, {tl.X, br.Y}}
+	for i, c := range corners {
+		corners[i] = m.applyFloat64(c)
+	}
+	bounds := boundingAABBOf(corners[:]...)
+	return NewAABB(
+		Vec[T]{floorTo[T](bounds.TopLeft.X), floorTo[T](bounds.TopLeft.Y)},
+		Vec[T]{ceilTo[T](bounds.BottomRight.X), ceilTo[T](bounds.BottomRight.Y)},
+	)
+}
+
+func (m Affine[T]) applyFloat64(v Vec[float64]) Vec[float64] {
+	return Vec[float64]{
+		X: m.A*v.X + m.C*v.Y + m.E,
+		Y: m.B*v.X + m.D*v.Y + m.F,
+	}
+}
+
+// floorTo and ceilTo convert back to T rounding towards -Inf and +Inf for integer
+// types; eps keeps float noise on exact grid values from widening the result.
+func floorTo[T Numeric](f float64) T {
+	if isFloating[T]() {
+		return T(f)
+	}
+	return T(int64(math.Floor(f*fixedScale[T]() + eps)))
+}
+
+func ceilTo[T Numeric](f float64) T {
+	if isFloating[T]() {
+		return T(f)
+	}
+	return T(int64(math.Ceil(f*fixedScale[T]() - eps)))
+}
@@ -0,0 +1,15 @@
+package geom
+
+import "fmt"
+
+func ExampleAffine_TransformAABB() {
+	// kamera: świat przesunięty o (-100,-50) i powiększony 2x
+	worldToScreen := NewAffineTranslate(NewVec(-100, -50)).Scale(2, 2)
+	screenToWorld, _ := worldToScreen.Inverse()
+
+	fmt.Println(worldToScreen.TransformAABB(NewAABB(NewVec(100, 50), NewVec(110, 60))))
+	fmt.Println(screenToWorld.Apply(NewVec(40, 20)))
+	// Output:
+	// {(0,0) (20,20)}
+	// (120,60)
+}
@@ -0,0 +1,97 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAffine(t *testing.T) {
+	runAffineTest[int](t, "int")
+	runAffineTest[uint32](t, "uint32")
+	runAffineTest[float64](t, "float64")
+}
+
+func runAffineTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		minusTwo := int32(-2)
+
+		testCases := []struct {
+			name     string
+			m        Affine[T]
+			in       Vec[T]
+			expected Vec[T]
+		}{
+			{name: "identity", m: NewAffineIdentity[T](), in: v(3, 4), expected: v(3, 4)},
+			{name: "translate", m: NewAffineTranslate(v(5, 1)), in: v(3, 4), expected: v(8, 5)},
+			{name: "translateNegative", m: NewAffineTranslate(v(T(minusTwo), 0)), in: v(3, 4), expected: v(1, 4)},
+			{name: "scale", m: NewAffineScale[T](2, 0.5), in: v(3, 4), expected: v(6, 2)},
+			{
+				// (3,4) obraca się na (-4,3); przesunięcie utrzymuje wynik dodatni dla uint32
+				name:     "rotate",
+				m:        NewAffineRotate[T](math.Pi / 2).Translate(v(8, 0)),
+				in:       v(3, 4),
+				expected: v(4, 3),
+			},
+			{name: "shear", m: NewAffineShear[T](1, 0), in: v(3, 4), expected: v(7, 4)},
+			{
+				name:     "scaleThenTranslate",
+				m:        NewAffineScale[T](2, 2).Translate(v(1, 1)),
+				in:       v(3, 4),
+				expected: v(7, 9),
+			},
+			{
+				name:     "translateThenScale",
+				m:        NewAffineTranslate(v(1, 1)).Scale(2, 2),
+				in:       v(3, 4),
+				expected: v(8, 10),
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := tc.m.Apply(tc.in); !approxEqualVec(got, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+
+		t.Run("inverse", func(t *testing.T) {
+			m := NewAffineRotate[T](0.3).Scale(2, 3).Translate(v(10, 20))
+			inv, ok := m.Inverse()
+			if !ok {
+				t.Fatalf("expected invertible transform")
+			}
+			p := v(7, 9)
+			if got := inv.Apply(m.Apply(p)); !approxEqualVec(got, p) {
+				t.Errorf("round trip: expected %v, got %v", p, got)
+			}
+			if _, ok := NewAffineScale[T](0, 1).Inverse(); ok {
+				t.Errorf("expected singular transform")
+			}
+			// mały zoom ma wyznacznik 1e-10, ale nie jest osobliwy
+			zoom, ok := NewAffineScale[T](1e-5, 1e-5).Inverse()
+			if !ok || math.Abs(zoom.A-1e5) > 1e-6 || math.Abs(zoom.D-1e5) > 1e-6 {
+				t.Errorf("expected 1e-5 zoom to invert to 1e5, got %v, %v", zoom, ok)
+			}
+			if _, ok := (Affine[T]{A: 1, B: 2, C: 2, D: 4}).Inverse(); ok {
+				t.Errorf("expected rank-one transform to be singular")
+			}
+			if _, ok := NewAffineScale[T](1e-200, 1e-200).Inverse(); ok {
+				t.Errorf("expected underflowing determinant to be singular")
+			}
+		})
+
+		t.Run("transformAABB", func(t *testing.T) {
+			box := NewAABB(v(0, 0), v(4, 2))
+			rotated := NewAffineRotate[T](math.Pi / 2).Translate(v(10, 10)).TransformAABB(box)
+			if expected := NewAABB(v(8, 10), v(10, 14)); rotated != expected {
+				t.Errorf("expected %v, got %v", expected, rotated)
+			}
+			// obrót o 45° daje wierzchołki niecałkowite – granice muszą objąć je z zapasem
+			diamond := NewAffineRotate[T](math.Pi / 4).Translate(v(10, 10)).TransformAABB(box)
+			if !diamond.Contains(NewAABB(v(9, 10), v(12, 14))) {
+				t.Errorf("expected %v to cover the rotated corners", diamond)
+			}
+		})
+	})
+}
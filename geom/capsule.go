@@ -0,0 +1,103 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Capsule is the set of points within Radius of Segment: a swept circle, which
+// makes it a good fit for bullets and other fast movers. The boundary belongs
+// to the capsule, so shapes that only touch it intersect.
+//
+// As with Circle, the tests run in float64 with unsigned components read as
+// signed.
+type Capsule[T Numeric] struct {
+	Segment Segment[T] `json:"segment"`
+	Radius  T          `json:"radius"`
+}
+
+// NewCapsule constructs a capsule around the segment from a to b.
+func NewCapsule[T Numeric](a, b Vec[T], radius T) Capsule[T] {
+	return Capsule[T]{Segment: NewSegment(a, b), Radius: radius}
+}
+
+// String formats the capsule as "{[(x,y) (x,y)] r=radius}".
+func (c Capsule[T]) String() string {
+	return fmt.Sprintf("{%v r=%v}", c.Segment, c.Radius)
+}
+
+// BoundingAABB returns the smallest AABB enclosing the capsule.
+func (c Capsule[T]) BoundingAABB() AABB[T] {
+	box := c.Segment.BoundingAABB()
+	r := Vec[T]{c.Radius, c.Radius}
+	return NewAABB(box.TopLeft.Sub(r), box.BottomRight.Add(r))
+}
+
+// ContainsVec reports whether v lies inside the capsule or on its boundary.
+func (c Capsule[T]) ContainsVec(v Vec[T]) bool {
+	a, b := c.Segment.floatForm()
+	return pointSegmentDistance(signedFloat64Vec(v), a, a.Add(b)) <= c.radius()+eps
+}
+
+// Intersects reports whether the capsule overlaps or touches box.
+func (c Capsule[T]) Intersects(box AABB[T]) bool {
+	a, d := c.Segment.floatForm()
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+	return segmentBoxDistance(a, a.Add(d), minV, maxV) <= c.radius()+eps
+}
+
+// IntersectsOBB reports whether the capsule overlaps or touches o. The segment
+// is moved into the box frame, where the test is the one against an AABB.
+func (c Capsule[T]) IntersectsOBB(o OBB[T]) bool {
+	a := o.toLocal(signedFloat64Vec(c.Segment.A))
+	b := o.toLocal(signedFloat64Vec(c.Segment.B))
+	h := o.halfExtents()
+	return segmentBoxDistance(a, b, Vec[float64]{-h.X, -h.Y}, h) <= c.radius()+eps
+}
+
+// IntersectsCircle reports whether the capsule overlaps or touches circle.
+func (c Capsule[T]) IntersectsCircle(circle Circle[T]) bool {
+	a, d := c.Segment.floatForm()
+	dist := pointSegmentDistance(signedFloat64Vec(circle.Center), a, a.Add(d))
+	return dist <= c.radius()+math.Abs(signedFloat64(circle.Radius))+eps
+}
+
+// IntersectsCapsule reports whether c and other overlap or touch. Crossing
+// segments are decided by the exact Segment.Intersects; otherwise the segments
+// are closest at an endpoint of one of them.
+func (c Capsule[T]) IntersectsCapsule(other Capsule[T]) bool {
+	if c.Segment.Intersects(other.Segment) {
+		return true
+	}
+	a, da := c.Segment.floatForm()
+	b, db := other.Segment.floatForm()
+	return segmentSegmentDistance(a, a.Add(da), b, b.Add(db)) <= c.radius()+other.radius()+eps
+}
+
+func (c Capsule[T]) radius() float64 { return math.Abs(signedFloat64(c.Radius)) }
+
+// segmentSegmentDistance returns the distance between segments a0-a1 and b0-b1
+// that do not cross, which is always reached at one of the four endpoints.
+func segmentSegmentDistance(a0, a1, b0, b1 Vec[float64]) float64 {
+	return min(
+		pointSegmentDistance(a0, b0, b1),
+		pointSegmentDistance(a1, b0, b1),
+		pointSegmentDistance(b0, a0, a1),
+		pointSegmentDistance(b1, a0, a1),
+	)
+}
+
+// segmentBoxDistance returns the distance between segment a-b and the closed
+// box [minV, maxV]; it is zero when the segment enters the box.
+func segmentBoxDistance(a, b, minV, maxV Vec[float64]) float64 {
+	entry, exit, _, ok := slabIntersect(a, b.Sub(a), minV, maxV, false)
+	if ok && entry <= exit && exit >= 0 && entry <= 1 {
+		return 0
+	}
+	corners := [4]Vec[float64]{minV, {maxV.X, minV.Y}, maxV, {minV.X, maxV.Y}}
+	dist := math.Inf(1)
+	for i, corner := range corners {
+		dist = min(dist, segmentSegmentDistance(a, b, corner, corners[(i+1)%4]))
+	}
+	return dist
+}
@@ -0,0 +1,52 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCapsule_Intersections(t *testing.T) {
+	runCapsuleIntersectionsTest[int](t, "int")
+	runCapsuleIntersectionsTest[uint32](t, "uint32")
+	runCapsuleIntersectionsTest[float64](t, "float64")
+}
+
+func runCapsuleIntersectionsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		bullet := NewCapsule(v(10, 10), v(30, 10), T(2))
+
+		testCases := []struct {
+			name     string
+			got      bool
+			expected bool
+		}{
+			{name: "containsAxis", got: bullet.ContainsVec(v(20, 10)), expected: true},
+			{name: "containsRim", got: bullet.ContainsVec(v(20, 12)), expected: true},
+			{name: "containsCap", got: bullet.ContainsVec(v(32, 10)), expected: true},
+			{name: "missesCapCorner", got: bullet.ContainsVec(v(32, 12)), expected: false},
+			{name: "aabbTouchingSide", got: bullet.Intersects(NewAABB(v(15, 12), v(18, 20))), expected: true},
+			{name: "aabbBelow", got: bullet.Intersects(NewAABB(v(15, 13), v(18, 20))), expected: false},
+			{name: "aabbAroundEnd", got: bullet.Intersects(NewAABB(v(25, 0), v(40, 20))), expected: true},
+			{name: "aabbBeyondCap", got: bullet.Intersects(NewAABB(v(32, 12), v(40, 20))), expected: false},
+			{name: "circleNearCap", got: bullet.IntersectsCircle(NewCircle(v(35, 10), T(3))), expected: true},
+			{name: "circleApart", got: bullet.IntersectsCircle(NewCircle(v(20, 16), T(3))), expected: false},
+			{name: "capsuleCrossing", got: bullet.IntersectsCapsule(NewCapsule(v(20, 0), v(20, 20), T(0))), expected: true},
+			{name: "capsuleParallel", got: bullet.IntersectsCapsule(NewCapsule(v(10, 14), v(30, 14), T(2))), expected: true},
+			{name: "capsuleParallelApart", got: bullet.IntersectsCapsule(NewCapsule(v(10, 15), v(30, 15), T(2))), expected: false},
+			{name: "obbRotatedNear", got: bullet.IntersectsOBB(NewOBB(v(20, 13), v(1, 1), math.Pi/4)), expected: true},
+			{name: "obbRotatedApart", got: bullet.IntersectsOBB(NewOBB(v(20, 16), v(1, 1), math.Pi/4)), expected: false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if tc.got != tc.expected {
+					t.Errorf("expected %v, got %v", tc.expected, tc.got)
+				}
+			})
+		}
+
+		if got := bullet.BoundingAABB(); got != NewAABB(v(8, 8), v(32, 12)) {
+			t.Errorf("expected bounds {(8,8) (32,12)}, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Circle is a disc defined by its center and radius. The boundary belongs to the
+// circle, so shapes that only touch it are reported as intersecting.
+//
+// For unsigned components the center is read as signed, matching
+// UnsignedIntVectorMath.Clamp and Wrap, so a center that underflowed past zero
+// behaves like a small negative coordinate instead of a huge positive one.
+type Circle[T Numeric] struct {
+	Center Vec[T] `json:"center"`
+	Radius T      `json:"radius"`
+}
+
+// NewCircle constructs a circle centered at center with the given radius.
+func NewCircle[T Numeric](center Vec[T], radius T) Circle[T] {
+	return Circle[T]{Center: center, Radius: radius}
+}
+
+// String formats the circle as "{(x,y) r=radius}".
+func (c Circle[T]) String() string {
+	return fmt.Sprintf("{%v r=%v}", c.Center, c.Radius)
+}
+
+// BoundingAABB returns the smallest AABB enclosing the circle.
+func (c Circle[T]) BoundingAABB() AABB[T] {
+	return NewAABBAround(c.Center, c.Radius)
+}
+
+// ContainsVec reports whether v lies inside the circle or on its boundary.
+func (c Circle[T]) ContainsVec(v Vec[T]) bool {
+	center := signedFloat64Vec(c.Center)
+	p := signedFloat64Vec(v)
+	return distSqFloat64(center, p) <= c.radiusSq()
+}
+
+// Intersects reports whether the circle overlaps or touches box.
+func (c Circle[T]) Intersects(box AABB[T]) bool {
+	center := signedFloat64Vec(c.Center)
+	closest := clampToBoxFloat64(center, box)
+	return distSqFloat64(center, closest) <= c.radiusSq()
+}
+
+// Contains reports whether box lies entirely within the circle.
+func (c Circle[T]) Contains(box AABB[T]) bool {
+	for _, corner := range aabbCornersFloat64(box) {
+		if distSqFloat64(signedFloat64Vec(c.Center), corner) > c.radiusSq() {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectsCircle reports whether c and other overlap or touch.
+func (c Circle[T]) IntersectsCircle(other Circle[T]) bool {
+	rSum := signedFloat64(c.Radius) + signedFloat64(other.Radius)
+	return distSqFloat64(signedFloat64Vec(c.Center), signedFloat64Vec(other.Center)) <= rSum*rSum
+}
+
+// ContainsCircle reports whether other lies entirely within c.
+func (c Circle[T]) ContainsCircle(other Circle[T]) bool {
+	d := math.Sqrt(distSqFloat64(signedFloat64Vec(c.Center), signedFloat64Vec(other.Center)))
+	return d+signedFloat64(other.Radius) <= signedFloat64(c.Radius)
+}
+
+// PenetrationDepth returns how far c and other overlap along the line joining
+// their centers, or zero when they are apart. Integer results are rounded up so
+// that pushing by the depth always separates the shapes.
+func (c Circle[T]) PenetrationDepth(other Circle[T]) T {
+	d := math.Sqrt(distSqFloat64(signedFloat64Vec(c.Center), signedFloat64Vec(other.Center)))
+	depth := signedFloat64(c.Radius) + signedFloat64(other.Radius) - d
+	if depth <= 0 {
+		return 0
+	}
+	return lengthTo[T](depth)
+}
+
+// PenetrationDepthAABB returns the distance the circle must move to stop
+// overlapping box, or zero when they are apart. When the center lies inside the
+// box the depth is measured to the nearest box edge.
+func (c Circle[T]) PenetrationDepthAABB(box AABB[T]) T {
+	center := signedFloat64Vec(c.Center)
+	r := signedFloat64(c.Radius)
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+
+	var depth float64
+	if center.X >= minV.X && center.X <= maxV.X && center.Y >= minV.Y && center.Y <= maxV.Y {
+		edge := min(center.X-minV.X, maxV.X-center.X, center.Y-minV.Y, maxV.Y-center.Y)
+		depth = r + edge
+	} else {
+		depth = r - math.Sqrt(distSqFloat64(center, clampToBoxFloat64(center, box)))
+	}
+	if depth <= 0 {
+		return 0
+	}
+	return lengthTo[T](depth)
+}
+
+func (c Circle[T]) radiusSq() float64 {
+	r := signedFloat64(c.Radius)
+	return r * r
+}
+
+func distSqFloat64(a, b Vec[float64]) float64 {
+	dx := a.X - b.X
+	dy := a.Y - b.Y
+	return dx*dx + dy*dy
+}
+
+// clampToBoxFloat64 returns the point of box nearest to p.
+func clampToBoxFloat64[T Numeric](p Vec[float64], box AABB[T]) Vec[float64] {
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+	return Vec[float64]{
+		X: min(max(p.X, minV.X), maxV.X),
+		Y: min(max(p.Y, minV.Y), maxV.Y),
+	}
+}
+
+func aabbCornersFloat64[T Numeric](box AABB[T]) [4]Vec[float64] {
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+	return [4]Vec[float64]{
+		minV,
+		{maxV.X, minV.Y},
+		{minV.X, maxV.Y},
+		maxV,
+	}
+}
@@ -0,0 +1,117 @@
+package geom
+
+import "testing"
+
+func TestCircle_AABBQueries(t *testing.T) {
+	runCircleAABBQueriesTest[int](t, "int")
+	runCircleAABBQueriesTest[uint32](t, "uint32")
+	runCircleAABBQueriesTest[float64](t, "float64")
+}
+
+func runCircleAABBQueriesTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		c := NewCircle(NewVec(T(10), T(10)), T(5))
+		mk := func(x, y, w, h T) AABB[T] {
+			return NewAABBAt(NewVec(x, y), w, h)
+		}
+
+		testCases := []struct {
+			name       string
+			box        AABB[T]
+			intersects bool
+			contains   bool
+			depth      T
+		}{
+			{name: "innerBox", box: mk(T(8), T(8), T(4), T(4)), intersects: true, contains: true, depth: T(7)},
+			{name: "overlapRight", box: mk(T(13), T(8), T(4), T(4)), intersects: true, depth: T(2)},
+			{name: "touchLeft", box: mk(T(1), T(8), T(4), T(4)), intersects: true, depth: T(0)},
+			{name: "cornerGap", box: mk(T(14), T(14), T(4), T(4)), intersects: false, depth: T(0)},
+			{name: "farAway", box: mk(T(30), T(30), T(4), T(4)), intersects: false, depth: T(0)},
+			{name: "enclosingBox", box: mk(T(0), T(0), T(20), T(20)), intersects: true, depth: T(15)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := c.Intersects(tc.box); got != tc.intersects {
+					t.Errorf("%v intersects %v = %v, want %v", c, tc.box, got, tc.intersects)
+				}
+				if got := c.Contains(tc.box); got != tc.contains {
+					t.Errorf("%v contains %v = %v, want %v", c, tc.box, got, tc.contains)
+				}
+				if got := c.PenetrationDepthAABB(tc.box); got != tc.depth {
+					t.Errorf("%v depth into %v = %v, want %v", c, tc.box, got, tc.depth)
+				}
+			})
+		}
+	})
+}
+
+func TestCircle_CircleQueries(t *testing.T) {
+	runCircleCircleQueriesTest[int](t, "int")
+	runCircleCircleQueriesTest[uint32](t, "uint32")
+	runCircleCircleQueriesTest[float64](t, "float64")
+}
+
+func runCircleCircleQueriesTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		c := NewCircle(NewVec(T(10), T(10)), T(5))
+
+		testCases := []struct {
+			name       string
+			other      Circle[T]
+			intersects bool
+			contains   bool
+			depth      T
+		}{
+			{name: "concentricSmaller", other: NewCircle(NewVec(T(10), T(10)), T(2)), intersects: true, contains: true, depth: T(7)},
+			{name: "overlapping", other: NewCircle(NewVec(T(16), T(10)), T(3)), intersects: true, depth: T(2)},
+			{name: "touching", other: NewCircle(NewVec(T(13), T(14)), T(0)), intersects: true, contains: true, depth: T(0)},
+			{name: "apart", other: NewCircle(NewVec(T(20), T(10)), T(3)), intersects: false, depth: T(0)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := c.IntersectsCircle(tc.other); got != tc.intersects {
+					t.Errorf("%v intersects %v = %v, want %v", c, tc.other, got, tc.intersects)
+				}
+				if got := c.ContainsCircle(tc.other); got != tc.contains {
+					t.Errorf("%v contains %v = %v, want %v", c, tc.other, got, tc.contains)
+				}
+				if got := c.PenetrationDepth(tc.other); got != tc.depth {
+					t.Errorf("%v depth into %v = %v, want %v", c, tc.other, got, tc.depth)
+				}
+			})
+		}
+	})
+}
+
+func TestCircle_BoundingAABB(t *testing.T) {
+	runCircleBoundingAABBTest[int](t, "int")
+	runCircleBoundingAABBTest[uint32](t, "uint32")
+	runCircleBoundingAABBTest[float64](t, "float64")
+}
+
+func runCircleBoundingAABBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		c := NewCircle(NewVec(T(5), T(7)), T(3))
+		want := NewAABB(NewVec(T(2), T(4)), NewVec(T(8), T(10)))
+		if got := c.BoundingAABB(); got != want {
+			t.Errorf("bounding box %v, want %v", got, want)
+		}
+	})
+}
+
+func TestCircle_Uint32NearOrigin(t *testing.T) {
+	// środek tuż przy zerze: lewa krawędź obwiedni zawija się jak w Clamp/Wrap
+	c := NewCircle(NewVec(uint32(1), uint32(1)), uint32(3))
+	box := c.BoundingAABB()
+	if int32(box.TopLeft.X) != -2 || int32(box.TopLeft.Y) != -2 {
+		t.Fatalf("expected top-left (-2,-2) as int32, got %v", box.TopLeft)
+	}
+	if !c.Intersects(NewAABBAt(NewVec(uint32(0), uint32(0)), 1, 1)) {
+		t.Errorf("circle near origin should intersect the origin cell")
+	}
+	if !c.ContainsVec(box.TopLeft.Add(NewVec(uint32(1), uint32(1)))) {
+		t.Errorf("circle should contain (-1,-1) read as int32")
+	}
+}
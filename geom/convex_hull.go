@@ -0,0 +1,67 @@
+package geom
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ConvexHull returns the convex hull of points using Andrew's monotone chain.
+//
+// The result is deterministic: duplicates are merged, collinear points on the
+// hull boundary are dropped, and vertices start at the point with the lowest X
+// (then lowest Y) and run with positive winding (see Polygon.SignedArea).
+// A single distinct point yields a one-vertex polygon and collinear input yields
+// its two extreme points. The input slice is not modified.
+func ConvexHull[T Numeric](points []Vec[T]) Polygon[T] {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, compareVecXY[T])
+	sorted = slices.Compact(sorted)
+
+	if len(sorted) < 3 {
+		return Polygon[T]{Vertices: sorted}
+	}
+
+	hull := make([]Vec[T], 0, 2*len(sorted))
+	// dolny łańcuch
+	for _, p := range sorted {
+		hull = popNonLeftTurns(hull, p, 2)
+		hull = append(hull, p)
+	}
+	// górny łańcuch
+	upperMin := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		p := sorted[i]
+		hull = popNonLeftTurns(hull, p, upperMin)
+		hull = append(hull, p)
+	}
+	// ostatni punkt powtarza pierwszy
+	hull = hull[:len(hull)-1]
+	return Polygon[T]{Vertices: hull}
+}
+
+// popNonLeftTurns drops trailing hull points that would make a clockwise or
+// straight turn towards p, keeping at least minLen-1 points.
+func popNonLeftTurns[T Numeric](hull []Vec[T], p Vec[T], minLen int) []Vec[T] {
+	for len(hull) >= minLen {
+		if Orient2D(hull[len(hull)-2], hull[len(hull)-1], p) > 0 {
+			break
+		}
+		hull = hull[:len(hull)-1]
+	}
+	return hull
+}
+
+// compareVecXY orders vectors by X then Y, reading unsigned components as signed.
+func compareVecXY[T Numeric](a, b Vec[T]) int {
+	if isFloating[T]() {
+		if c := cmp.Compare(a.X, b.X); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Y, b.Y)
+	}
+	// porównanie na int64, żeby szerokie typy (uint64, int64) nie traciły precyzji
+	if c := cmp.Compare(signedInt64(a.X), signedInt64(b.X)); c != 0 {
+		return c
+	}
+	return cmp.Compare(signedInt64(a.Y), signedInt64(b.Y))
+}
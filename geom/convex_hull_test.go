@@ -0,0 +1,57 @@
+package geom
+
+import "testing"
+
+func TestConvexHull(t *testing.T) {
+	runConvexHullTest[int](t, "int")
+	runConvexHullTest[uint32](t, "uint32")
+	runConvexHullTest[float64](t, "float64")
+}
+
+func runConvexHullTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+
+		testCases := []struct {
+			name   string
+			points []Vec[T]
+			want   []Vec[T]
+		}{
+			{name: "empty", points: nil, want: []Vec[T]{}},
+			{name: "singleWithDuplicates", points: []Vec[T]{v(3, 3), v(3, 3)}, want: []Vec[T]{v(3, 3)}},
+			{name: "collinear", points: []Vec[T]{v(2, 2), v(0, 0), v(4, 4), v(1, 1)}, want: []Vec[T]{v(0, 0), v(4, 4)}},
+			{
+				name:   "squareWithInteriorAndEdgePoints",
+				points: []Vec[T]{v(4, 4), v(2, 2), v(0, 4), v(2, 0), v(0, 0), v(4, 0), v(4, 4), v(0, 2)},
+				want:   []Vec[T]{v(0, 0), v(4, 0), v(4, 4), v(0, 4)},
+			},
+			{
+				name:   "triangle",
+				points: []Vec[T]{v(5, 1), v(1, 1), v(3, 6), v(3, 2)},
+				want:   []Vec[T]{v(1, 1), v(5, 1), v(3, 6)},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got := ConvexHull(tc.points).Vertices
+				if len(got) != len(tc.want) {
+					t.Fatalf("hull = %v, want %v", got, tc.want)
+				}
+				for i := range got {
+					if got[i] != tc.want[i] {
+						t.Fatalf("hull = %v, want %v", got, tc.want)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestConvexHull_DoesNotModifyInput(t *testing.T) {
+	points := []Vec[int]{{3, 3}, {0, 0}, {3, 0}, {0, 3}, {1, 1}}
+	ConvexHull(points)
+	if points[0] != NewVec(3, 3) || points[4] != NewVec(1, 1) {
+		t.Errorf("input was reordered: %v", points)
+	}
+}
@@ -0,0 +1,414 @@
+package geom
+
+import "math"
+
+// QuadraticBezier is a Bézier curve with one control point, running from P0
+// (t=0) to P2 (t=1).
+type QuadraticBezier[T Numeric] struct {
+	P0, P1, P2 Vec[T]
+}
+
+// CubicBezier is a Bézier curve with two control points, running from P0 (t=0)
+// to P3 (t=1).
+type CubicBezier[T Numeric] struct {
+	P0, P1, P2, P3 Vec[T]
+}
+
+// CatmullRom is a uniform Catmull–Rom spline passing through every point of
+// Points in order. The end points are duplicated as phantom neighbours, so the
+// spline starts at the first point and ends at the last. The parameter t runs
+// from 0 to 1 across the whole spline, with each span taking an equal share.
+type CatmullRom[T Numeric] struct {
+	Points []Vec[T]
+}
+
+// NewQuadraticBezier creates a quadratic Bézier curve.
+func NewQuadraticBezier[T Numeric](p0, p1, p2 Vec[T]) QuadraticBezier[T] {
+	return QuadraticBezier[T]{P0: p0, P1: p1, P2: p2}
+}
+
+// NewCubicBezier creates a cubic Bézier curve.
+func NewCubicBezier[T Numeric](p0, p1, p2, p3 Vec[T]) CubicBezier[T] {
+	return CubicBezier[T]{P0: p0, P1: p1, P2: p2, P3: p3}
+}
+
+// NewCatmullRom creates a Catmull–Rom spline through points.
+func NewCatmullRom[T Numeric](points ...Vec[T]) CatmullRom[T] {
+	return CatmullRom[T]{Points: points}
+}
+
+// Eval returns the point at t in [0, 1], rounded to the grid for integer types.
+func (c QuadraticBezier[T]) Eval(t float64) Vec[T] { return evalPath[T](c.path(), t) }
+
+// Derivative returns dP/dt at t, kept in float64 because tangents rarely fall on the grid.
+func (c QuadraticBezier[T]) Derivative(t float64) Vec[float64] { return c.path().derivative(t) }
+
+// Length returns the arc length of the curve.
+func (c QuadraticBezier[T]) Length() float64 { return c.path().length() }
+
+// ParamAtLength returns the t at which the arc length from the start reaches s.
+func (c QuadraticBezier[T]) ParamAtLength(s float64) float64 { return c.path().paramAtLength(s) }
+
+// EvalAtLength returns the point at arc length s, for movement at constant speed.
+func (c QuadraticBezier[T]) EvalAtLength(s float64) Vec[T] { return evalAtLength[T](c.path(), s) }
+
+// Flatten approximates the curve with a polyline deviating from it by at most
+// tolerance; more points are placed where the curve bends.
+func (c QuadraticBezier[T]) Flatten(tolerance float64) []Vec[T] {
+	return flattenPath[T](c.path(), tolerance)
+}
+
+// BoundingAABB returns the exact bounds from the curve's extrema; integer bounds
+// are rounded outwards.
+func (c QuadraticBezier[T]) BoundingAABB() AABB[T] { return pathBounds[T](c.path()) }
+
+// Eval returns the point at t in [0, 1], rounded to the grid for integer types.
+func (c CubicBezier[T]) Eval(t float64) Vec[T] { return evalPath[T](c.path(), t) }
+
+// Derivative returns dP/dt at t, kept in float64 because tangents rarely fall on the grid.
+func (c CubicBezier[T]) Derivative(t float64) Vec[float64] { return c.path().derivative(t) }
+
+// Length returns the arc length of the curve.
+func (c CubicBezier[T]) Length() float64 { return c.path().length() }
+
+// ParamAtLength returns the t at which the arc length from the start reaches s.
+func (c CubicBezier[T]) ParamAtLength(s float64) float64 { return c.path().paramAtLength(s) }
+
+// EvalAtLength returns the point at arc length s, for movement at constant speed.
+func (c CubicBezier[T]) EvalAtLength(s float64) Vec[T] { return evalAtLength[T](c.path(), s) }
+
+// Flatten approximates the curve with a polyline deviating from it by at most
+// tolerance; more points are placed where the curve bends.
+func (c CubicBezier[T]) Flatten(tolerance float64) []Vec[T] {
+	return flattenPath[T](c.path(), tolerance)
+}
+
+// BoundingAABB returns the exact bounds from the curve's extrema; integer bounds
+// are rounded outwards.
+func (c CubicBezier[T]) BoundingAABB() AABB[T] { return pathBounds[T](c.path()) }
+
+// Eval returns the point at t in [0, 1], rounded to the grid for integer types.
+func (c CatmullRom[T]) Eval(t float64) Vec[T] { return evalPath[T](c.path(), t) }
+
+// Derivative returns dP/dt at t, kept in float64 because tangents rarely fall on the grid.
+func (c CatmullRom[T]) Derivative(t float64) Vec[float64] { return c.path().derivative(t) }
+
+// Length returns the arc length of the curve.
+func (c CatmullRom[T]) Length() float64 { return c.path().length() }
+
+// ParamAtLength returns the t at which the arc length from the start reaches s.
+func (c CatmullRom[T]) ParamAtLength(s float64) float64 { return c.path().paramAtLength(s) }
+
+// EvalAtLength returns the point at arc length s, for movement at constant speed.
+func (c CatmullRom[T]) EvalAtLength(s float64) Vec[T] { return evalAtLength[T](c.path(), s) }
+
+// Flatten approximates the curve with a polyline deviating from it by at most
+// tolerance; more points are placed where the curve bends.
+func (c CatmullRom[T]) Flatten(tolerance float64) []Vec[T] {
+	return flattenPath[T](c.path(), tolerance)
+}
+
+// BoundingAABB returns the exact bounds from the curve's extrema; integer bounds
+// are rounded outwards.
+func (c CatmullRom[T]) BoundingAABB() AABB[T] { return pathBounds[T](c.path()) }
+
+// -----------------------------------------------------------------------------
+
+// path elevates the quadratic to the equivalent cubic.
+func (c QuadraticBezier[T]) path() cubicPath {
+	p0, p1, p2 := signedFloat64Vec(c.P0), signedFloat64Vec(c.P1), signedFloat64Vec(c.P2)
+	return cubicPath{{
+		p0,
+		Vec[float64]{p0.X + 2*(p1.X-p0.X)/3, p0.Y + 2*(p1.Y-p0.Y)/3},
+		Vec[float64]{p2.X + 2*(p1.X-p2.X)/3, p2.Y + 2*(p1.Y-p2.Y)/3},
+		p2,
+	}}
+}
+
+func (c CubicBezier[T]) path() cubicPath {
+	return cubicPath{{signedFloat64Vec(c.P0), signedFloat64Vec(c.P1), signedFloat64Vec(c.P2), signedFloat64Vec(c.P3)}}
+}
+
+// path converts every span of the spline to its equivalent cubic Bézier.
+func (c CatmullRom[T]) path() cubicPath {
+	n := len(c.Points)
+	switch n {
+	case 0:
+		return nil
+	case 1:
+		p := signedFloat64Vec(c.Points[0])
+		return cubicPath{{p, p, p, p}}
+	}
+	at := func(i int) Vec[float64] { return signedFloat64Vec(c.Points[min(max(i, 0), n-1)]) }
+	path := make(cubicPath, n-1)
+	for i := range path {
+		p0, p1, p2, p3 := at(i-1), at(i), at(i+1), at(i+2)
+		path[i] = cubicFloat64{
+			p1,
+			Vec[float64]{p1.X + (p2.X-p0.X)/6, p1.Y + (p2.Y-p0.Y)/6},
+			Vec[float64]{p2.X - (p3.X-p1.X)/6, p2.Y - (p3.Y-p1.Y)/6},
+			p2,
+		}
+	}
+	return path
+}
+
+// -----------------------------------------------------------------------------
+
+func evalPath[T Numeric](p cubicPath, t float64) Vec[T] { return roundToVec[T](p.point(t)) }
+
+func evalAtLength[T Numeric](p cubicPath, s float64) Vec[T] {
+	return roundToVec[T](p.point(p.paramAtLength(s)))
+}
+
+func flattenPath[T Numeric](p cubicPath, tolerance float64) []Vec[T] {
+	pts := p.flatten(tolerance)
+	out := make([]Vec[T], 0, len(pts))
+	for _, v := range pts {
+		// po zaokrągleniu sąsiednie punkty mogą się pokryć
+		if q := roundToVec[T](v); len(out) == 0 || out[len(out)-1] != q {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+func pathBounds[T Numeric](p cubicPath) AABB[T] {
+	if len(p) == 0 {
+		return AABB[T]{}
+	}
+	var minV, maxV Vec[float64]
+	for i, c := range p {
+		lo, hi := c.bounds()
+		if i == 0 {
+			minV, maxV = lo, hi
+			continue
+		}
+		minV = Vec[float64]{min(minV.X, lo.X), min(minV.Y, lo.Y)}
+		maxV = Vec[float64]{max(maxV.X, hi.X), max(maxV.Y, hi.Y)}
+	}
+	return NewAABB(
+		Vec[T]{floorTo[T](minV.X), floorTo[T](minV.Y)},
+		Vec[T]{ceilTo[T](maxV.X), ceilTo[T](maxV.Y)},
+	)
+}
+
+// cubicPath is a chain of cubic Bézier pieces sharing the global parameter t in
+// [0, 1], each piece taking an equal share.
+type cubicPath []cubicFloat64
+
+// piece maps the global t to a piece index and its local parameter.
+func (p cubicPath) piece(t float64) (int, float64) {
+	t = min(max(t, 0), 1)
+	scaled := t * float64(len(p))
+	i := min(int(scaled), len(p)-1)
+	return i, scaled - float64(i)
+}
+
+func (p cubicPath) point(t float64) Vec[float64] {
+	if len(p) == 0 {
+		return Vec[float64]{}
+	}
+	i, u := p.piece(t)
+	return p[i].point(u)
+}
+
+func (p cubicPath) derivative(t float64) Vec[float64] {
+	if len(p) == 0 {
+		return Vec[float64]{}
+	}
+	i, u := p.piece(t)
+	d := p[i].derivative(u)
+	n := float64(len(p))
+	return Vec[float64]{d.X * n, d.Y * n}
+}
+
+func (p cubicPath) length() float64 {
+	var total float64
+	for _, c := range p {
+		total += c.length(0, 1)
+	}
+	return total
+}
+
+// paramAtLength inverts the arc length with Newton steps guarded by bisection.
+func (p cubicPath) paramAtLength(s float64) float64 {
+	if len(p) == 0 || s <= 0 {
+		return 0
+	}
+	for i, c := range p {
+		pieceLen := c.length(0, 1)
+		if s > pieceLen && i < len(p)-1 {
+			s -= pieceLen
+			continue
+		}
+		if s >= pieceLen {
+			return 1
+		}
+		lo, hi, u := 0.0, 1.0, s/pieceLen
+		for range 50 {
+			f := c.length(0, u) - s
+			if math.Abs(f) <= eps*max(pieceLen, 1) {
+				break
+			}
+			if f > 0 {
+				hi = u
+			} else {
+				lo = u
+			}
+			d := c.derivative(u)
+			next := u - f/math.Hypot(d.X, d.Y)
+			if math.IsNaN(next) || next <= lo || next >= hi {
+				next = (lo + hi) / 2
+			}
+			u = next
+		}
+		return (float64(i) + u) / float64(len(p))
+	}
+	return 1
+}
+
+// maxFlattenDepth bounds the subdivision for degenerate tolerances (up to 2^12
+// chords per piece).
+const maxFlattenDepth = 12
+
+func (p cubicPath) flatten(tolerance float64) []Vec[float64] {
+	if len(p) == 0 {
+		return nil
+	}
+	out := []Vec[float64]{p[0].p0}
+	var subdivide func(c cubicFloat64, depth int)
+	subdivide = func(c cubicFloat64, depth int) {
+		if depth >= maxFlattenDepth || c.flatness() <= tolerance {
+			out = append(out, c.p3)
+			return
+		}
+		left, right := c.split(0.5)
+		subdivide(left, depth+1)
+		subdivide(right, depth+1)
+	}
+	for _, c := range p {
+		subdivide(c, 0)
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+
+type cubicFloat64 struct{ p0, p1, p2, p3 Vec[float64] }
+
+func (c cubicFloat64) point(t float64) Vec[float64] {
+	s := 1 - t
+	a, b, d, e := s*s*s, 3*s*s*t, 3*s*t*t, t*t*t
+	return Vec[float64]{
+		a*c.p0.X + b*c.p1.X + d*c.p2.X + e*c.p3.X,
+		a*c.p0.Y + b*c.p1.Y + d*c.p2.Y + e*c.p3.Y,
+	}
+}
+
+func (c cubicFloat64) derivative(t float64) Vec[float64] {
+	s := 1 - t
+	a, b, d := 3*s*s, 6*s*t, 3*t*t
+	return Vec[float64]{
+		a*(c.p1.X-c.p0.X) + b*(c.p2.X-c.p1.X) + d*(c.p3.X-c.p2.X),
+		a*(c.p1.Y-c.p0.Y) + b*(c.p2.Y-c.p1.Y) + d*(c.p3.Y-c.p2.Y),
+	}
+}
+
+// split divides the curve at t with de Casteljau's construction.
+func (c cubicFloat64) split(t float64) (cubicFloat64, cubicFloat64) {
+	lerp := func(a, b Vec[float64]) Vec[float64] { return lerpFloat64(a, b, t) }
+	p01, p12, p23 := lerp(c.p0, c.p1), lerp(c.p1, c.p2), lerp(c.p2, c.p3)
+	p012, p123 := lerp(p01, p12), lerp(p12, p23)
+	mid := lerp(p012, p123)
+	return cubicFloat64{c.p0, p01, p012, mid}, cubicFloat64{mid, p123, p23, c.p3}
+}
+
+// flatness bounds the distance between the curve and its chord by the distance
+// of the control points from it (the curve lies in their convex hull).
+func (c cubicFloat64) flatness() float64 {
+	return max(pointSegmentDistance(c.p1, c.p0, c.p3), pointSegmentDistance(c.p2, c.p0, c.p3))
+}
+
+// bounds returns the exact extent of the curve from its end points and the
+// roots of the derivative on each axis.
+func (c cubicFloat64) bounds() (Vec[float64], Vec[float64]) {
+	minV := Vec[float64]{min(c.p0.X, c.p3.X), min(c.p0.Y, c.p3.Y)}
+	maxV := Vec[float64]{max(c.p0.X, c.p3.X), max(c.p0.Y, c.p3.Y)}
+	axes := [2][4]float64{
+		{c.p0.X, c.p1.X, c.p2.X, c.p3.X},
+		{c.p0.Y, c.p1.Y, c.p2.Y, c.p3.Y},
+	}
+	for axis, q := range axes {
+		// B'(t)/3 = a·t² + b·t + k
+		a := -q[0] + 3*q[1] - 3*q[2] + q[3]
+		b := 2 * (q[0] - 2*q[1] + q[2])
+		k := q[1] - q[0]
+		for _, t := range quadraticRoots(a, b, k) {
+			if t <= 0 || t >= 1 {
+				continue
+			}
+			p := c.point(t)
+			if axis == 0 {
+				minV.X, maxV.X = min(minV.X, p.X), max(maxV.X, p.X)
+			} else {
+				minV.Y, maxV.Y = min(minV.Y, p.Y), max(maxV.Y, p.Y)
+			}
+		}
+	}
+	return minV, maxV
+}
+
+// length integrates the speed between t0 and t1 with adaptive Gauss–Legendre
+// quadrature.
+func (c cubicFloat64) length(t0, t1 float64) float64 {
+	return c.adaptiveLength(t0, t1, c.gaussLength(t0, t1), 0)
+}
+
+func (c cubicFloat64) adaptiveLength(t0, t1, whole float64, depth int) float64 {
+	mid := (t0 + t1) / 2
+	left, right := c.gaussLength(t0, mid), c.gaussLength(mid, t1)
+	if depth >= 16 || math.Abs(left+right-whole) <= eps*max(whole, 1) {
+		return left + right
+	}
+	return c.adaptiveLength(t0, mid, left, depth+1) + c.adaptiveLength(mid, t1, right, depth+1)
+}
+
+// Five-point Gauss–Legendre nodes and weights on [-1, 1].
+var (
+	gaussNodes   = [5]float64{0, -0.5384693101056831, 0.5384693101056831, -0.9061798459386640, 0.9061798459386640}
+	gaussWeights = [5]float64{0.5688888888888889, 0.4786286704993665, 0.4786286704993665, 0.2369268850561891, 0.2369268850561891}
+)
+
+func (c cubicFloat64) gaussLength(t0, t1 float64) float64 {
+	half, center := (t1-t0)/2, (t0+t1)/2
+	var sum float64
+	for i, x := range gaussNodes {
+		d := c.derivative(center + half*x)
+		sum += gaussWeights[i] * math.Hypot(d.X, d.Y)
+	}
+	return sum * half
+}
+
+// quadraticRoots returns the real roots of a·t² + b·t + c, degrading to the
+// linear case when a is zero. Coefficients count as zero relative to the
+// largest one, so curves in small units keep their extrema.
+func quadraticRoots(a, b, c float64) []float64 {
+	scale := max(math.Abs(a), math.Abs(b), math.Abs(c))
+	if math.Abs(a) <= eps*scale {
+		if math.Abs(b) <= eps*scale {
+			return nil
+		}
+		return []float64{-c / b}
+	}
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return nil
+	}
+	// postać numerycznie stabilna – unika odejmowania bliskich liczb
+	q := -(b + math.Copysign(math.Sqrt(disc), b)) / 2
+	if q == 0 {
+		return []float64{0}
+	}
+	return []float64{q / a, c / q}
+}
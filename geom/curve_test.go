@@ -0,0 +1,111 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurves_Eval(t *testing.T) {
+	runCurvesEvalTest[int](t, "int")
+	runCurvesEvalTest[uint32](t, "uint32")
+	runCurvesEvalTest[float64](t, "float64")
+}
+
+func runCurvesEvalTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		quad := NewQuadraticBezier(v(0, 0), v(10, 20), v(20, 0))
+		cubic := NewCubicBezier(v(0, 0), v(0, 40), v(40, 40), v(40, 0))
+		spline := NewCatmullRom(v(0, 0), v(10, 10), v(20, 0), v(30, 10))
+
+		testCases := []struct {
+			name     string
+			got      Vec[T]
+			expected Vec[T]
+		}{
+			{name: "quadStart", got: quad.Eval(0), expected: v(0, 0)},
+			{name: "quadMid", got: quad.Eval(0.5), expected: v(10, 10)},
+			{name: "quadEnd", got: quad.Eval(1), expected: v(20, 0)},
+			{name: "cubicMid", got: cubic.Eval(0.5), expected: v(20, 30)},
+			{name: "splineKnot", got: spline.Eval(1.0 / 3), expected: v(10, 10)},
+			{name: "splineKnot2", got: spline.Eval(2.0 / 3), expected: v(20, 0)},
+			{name: "splineEnd", got: spline.Eval(1), expected: v(30, 10)},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if !approxEqualVec(tc.got, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, tc.got)
+				}
+			})
+		}
+
+		t.Run("boundsFromExtrema", func(t *testing.T) {
+			// punkty kontrolne sięgają y=20/40, sama krzywa tylko 10/30
+			if got := quad.BoundingAABB(); got != NewAABB(v(0, 0), v(20, 10)) {
+				t.Errorf("quad: expected {(0,0) (20,10)}, got %v", got)
+			}
+			if got := cubic.BoundingAABB(); got != NewAABB(v(0, 0), v(40, 30)) {
+				t.Errorf("cubic: expected {(0,0) (40,30)}, got %v", got)
+			}
+		})
+
+		t.Run("flattenKeepsEndpointsAndTolerance", func(t *testing.T) {
+			pts := cubic.Flatten(0.25)
+			if pts[0] != cubic.P0 || pts[len(pts)-1] != cubic.P3 {
+				t.Fatalf("expected flattened path to keep endpoints, got %v", pts)
+			}
+			if len(pts) < 8 {
+				t.Errorf("expected a fine polyline, got %d points", len(pts))
+			}
+			coarse := cubic.Flatten(10)
+			if len(coarse) >= len(pts) {
+				t.Errorf("expected coarser tolerance to give fewer points: %d vs %d", len(coarse), len(pts))
+			}
+		})
+	})
+}
+
+func TestCurves_BoundsInSmallUnits(t *testing.T) {
+	// ta sama krzywa co w runCurvesEvalTest, przeskalowana o 1e-12
+	const unit = 1e-12
+	cubic := NewCubicBezier(
+		NewVec(0.0, 0.0), NewVec(0.0, 40*unit), NewVec(40*unit, 40*unit), NewVec(40*unit, 0.0),
+	)
+	box := cubic.BoundingAABB()
+	if math.Abs(box.BottomRight.Y-30*unit) > 1e-6*unit {
+		t.Errorf("expected the curve to peak at y=%v, got %v", 30*unit, box)
+	}
+}
+
+func TestCurves_ArcLength(t *testing.T) {
+	line := NewCubicBezier(NewVec(0.0, 0.0), NewVec(1.0, 0.0), NewVec(2.0, 0.0), NewVec(10.0, 0.0))
+	if l := line.Length(); math.Abs(l-10) > 1e-9 {
+		t.Errorf("expected straight length 10, got %v", l)
+	}
+	// rozłożenie punktów kontrolnych jest nierównomierne, ale po długości łuku punkt musi być w połowie
+	if p := line.EvalAtLength(5); math.Abs(p.X-5) > 1e-6 {
+		t.Errorf("expected point at x=5, got %v", p)
+	}
+
+	// ćwiartka okręgu o promieniu 100 w przybliżeniu kubicznym
+	k := 100 * 4 * (math.Sqrt2 - 1) / 3
+	arc := NewCubicBezier(NewVec(100.0, 0.0), NewVec(100.0, k), NewVec(k, 100.0), NewVec(0.0, 100.0))
+	if l := arc.Length(); math.Abs(l-50*math.Pi) > 0.05 {
+		t.Errorf("expected quarter circle length ≈ %v, got %v", 50*math.Pi, l)
+	}
+	mid := arc.EvalAtLength(arc.Length() / 2)
+	if math.Abs(mid.X-mid.Y) > 1e-6 {
+		t.Errorf("expected symmetric midpoint, got %v", mid)
+	}
+
+	spline := NewCatmullRom(NewVec(0.0, 0.0), NewVec(10.0, 0.0), NewVec(30.0, 0.0))
+	if l := spline.Length(); math.Abs(l-30) > 1e-9 {
+		t.Errorf("expected collinear spline length 30, got %v", l)
+	}
+	if p := spline.EvalAtLength(20); math.Abs(p.X-20) > 1e-6 {
+		t.Errorf("expected x=20 at length 20, got %v", p)
+	}
+	if d := spline.Derivative(0.25); d.Y != 0 || d.X <= 0 {
+		t.Errorf("expected derivative along +X, got %v", d)
+	}
+}
@@ -0,0 +1,189 @@
+package geom
+
+import "slices"
+
+// Triangulation is a set of triangles over Points. Each triangle stores three
+// indices into Points, ordered with positive winding (see Polygon.SignedArea).
+type Triangulation[T Numeric] struct {
+	Points    []Vec[T]
+	Triangles [][3]int
+}
+
+// Delaunay triangulates points with the Bowyer–Watson algorithm so that no
+// point lies strictly inside the circumcircle of any triangle.
+//
+// Triangle indices refer to the original slice. Duplicate points are
+// triangulated once, under the index of their first occurrence. Fewer than three
+// distinct points or fully collinear input yield no triangles. The in-circle
+// decisions use the exact InCircle predicate.
+func Delaunay[T Numeric](points []Vec[T]) Triangulation[T] {
+	result := Triangulation[T]{Points: points}
+	unique := uniquePointIndices(points)
+	if len(unique) < 3 {
+		return result
+	}
+
+	// obliczenia na float64, żeby supertrójkąt zmieścił się także dla uint32
+	pts := make([]Vec[float64], len(points), len(points)+3)
+	for i, p := range points {
+		pts[i] = signedFloat64Vec(p)
+	}
+	pts = append(pts, superTriangle(pts)...)
+	super := len(points)
+
+	triangles := [][3]int{{super, super + 1, super + 2}}
+	for _, idx := range unique {
+		p := pts[idx]
+		var bad [][3]int
+		kept := triangles[:0]
+		for _, tri := range triangles {
+			if InCircle(pts[tri[0]], pts[tri[1]], pts[tri[2]], p) > 0 {
+				bad = append(bad, tri)
+			} else {
+				kept = append(kept, tri)
+			}
+		}
+		triangles = kept
+		for _, edge := range cavityBoundary(bad) {
+			triangles = append(triangles, [3]int{edge[0], edge[1], idx})
+		}
+	}
+
+	for _, tri := range triangles {
+		if tri[0] < super && tri[1] < super && tri[2] < super {
+			result.Triangles = append(result.Triangles, tri)
+		}
+	}
+	return result
+}
+
+// Voronoi returns the Voronoi cell of every point clipped to viewport, indexed
+// like points. Cells are convex polygons with positive winding; duplicates
+// share the cell of their first occurrence and points whose cell misses the
+// viewport get an empty polygon. Integer cell vertices are rounded to the grid.
+func Voronoi[T Numeric](points []Vec[T], viewport AABB[T]) []Polygon[T] {
+	neighbours := make(map[int][]int, len(points))
+	for _, tri := range Delaunay(points).Triangles {
+		for i := range 3 {
+			a, b := tri[i], tri[(i+1)%3]
+			neighbours[a] = append(neighbours[a], b)
+			neighbours[b] = append(neighbours[b], a)
+		}
+	}
+
+	unique := uniquePointIndices(points)
+	if len(unique) == 2 {
+		// bez trójkątów – jedyną granicą jest symetralna dwóch punktów
+		neighbours[unique[0]] = []int{unique[1]}
+		neighbours[unique[1]] = []int{unique[0]}
+	} else if len(unique) > 2 && len(neighbours) == 0 {
+		// punkty współliniowe – sąsiadami są kolejne punkty na prostej
+		for i := 0; i+1 < len(unique); i++ {
+			a, b := unique[i], unique[i+1]
+			neighbours[a] = append(neighbours[a], b)
+			neighbours[b] = append(neighbours[b], a)
+		}
+	}
+
+	viewportPoly := polygonFloat64(NewPolygonFromAABB(viewport).Vertices)
+	cells := make([]Polygon[T], len(points))
+	first := make(map[Vec[T]]int, len(points))
+	for i, p := range points {
+		if j, ok := first[p]; ok {
+			cells[i] = cells[j]
+			continue
+		}
+		first[p] = i
+
+		site := signedFloat64Vec(p)
+		cell := viewportPoly
+		for _, n := range neighbours[i] {
+			cell = clipByBisector(cell, site, signedFloat64Vec(points[n]))
+		}
+		vertices := make([]Vec[T], len(cell))
+		for k, v := range cell {
+			vertices[k] = roundToVec[T](v)
+		}
+		cells[i] = NewPolygon(vertices...)
+	}
+	return cells
+}
+
+// uniquePointIndices returns the index of the first occurrence of every distinct
+// point, ordered by X then Y so the triangulation does not depend on input order.
+func uniquePointIndices[T Numeric](points []Vec[T]) []int {
+	seen := make(map[Vec[T]]struct{}, len(points))
+	unique := make([]int, 0, len(points))
+	for i, p := range points {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		unique = append(unique, i)
+	}
+	slices.SortStableFunc(unique, func(a, b int) int {
+		return compareVecXY(points[a], points[b])
+	})
+	return unique
+}
+
+// superTriangle returns a positively wound triangle that comfortably encloses pts.
+func superTriangle(pts []Vec[float64]) []Vec[float64] {
+	bounds := boundingAABBOf(pts...)
+	span := max(bounds.BottomRight.X-bounds.TopLeft.X, bounds.BottomRight.Y-bounds.TopLeft.Y, 1)
+	// duży margines zmniejsza ryzyko brakujących krawędzi otoczki; predykaty są dokładne
+	d := span * 1e6
+	cx := (bounds.TopLeft.X + bounds.BottomRight.X) / 2
+	cy := (bounds.TopLeft.Y + bounds.BottomRight.Y) / 2
+	return []Vec[float64]{
+		{cx - 2*d, cy - d},
+		{cx + 2*d, cy - d},
+		{cx, cy + 2*d},
+	}
+}
+
+// cavityBoundary returns the directed edges of bad triangles that are not shared
+// with another bad triangle, keeping their positive orientation.
+func cavityBoundary(bad [][3]int) [][2]int {
+	count := make(map[[2]int]int, 3*len(bad))
+	for _, tri := range bad {
+		for i := range 3 {
+			a, b := tri[i], tri[(i+1)%3]
+			count[[2]int{min(a, b), max(a, b)}]++
+		}
+	}
+	var edges [][2]int
+	for _, tri := range bad {
+		for i := range 3 {
+			a, b := tri[i], tri[(i+1)%3]
+			if count[[2]int{min(a, b), max(a, b)}] == 1 {
+				edges = append(edges, [2]int{a, b})
+			}
+		}
+	}
+	return edges
+}
+
+// clipByBisector keeps the part of the convex polygon closer to site than to other
+// (Sutherland–Hodgman against a single half-plane).
+func clipByBisector(poly []Vec[float64], site, other Vec[float64]) []Vec[float64] {
+	normal := other.Sub(site)
+	limit := (dotFloat64(other, other) - dotFloat64(site, site)) / 2
+	inside := func(v Vec[float64]) bool { return dotFloat64(v, normal) <= limit }
+
+	var out []Vec[float64]
+	for i, cur := range poly {
+		prev := poly[(i+len(poly)-1)%len(poly)]
+		curIn, prevIn := inside(cur), inside(prev)
+		if curIn != prevIn {
+			dPrev := dotFloat64(prev, normal) - limit
+			dCur := dotFloat64(cur, normal) - limit
+			t := dPrev / (dPrev - dCur)
+			out = append(out, Vec[float64]{prev.X + (cur.X-prev.X)*t, prev.Y + (cur.Y-prev.Y)*t})
+		}
+		if curIn {
+			out = append(out, cur)
+		}
+	}
+	return out
+}
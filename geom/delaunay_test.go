@@ -0,0 +1,128 @@
+package geom
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDelaunay(t *testing.T) {
+	runDelaunayTest[int](t, "int")
+	runDelaunayTest[uint32](t, "uint32")
+	runDelaunayTest[float64](t, "float64")
+}
+
+func runDelaunayTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+
+		testCases := []struct {
+			name      string
+			points    []Vec[T]
+			triangles int
+		}{
+			{name: "tooFew", points: []Vec[T]{v(0, 0), v(4, 0)}, triangles: 0},
+			{name: "collinear", points: []Vec[T]{v(0, 0), v(2, 2), v(4, 4)}, triangles: 0},
+			{name: "triangle", points: []Vec[T]{v(0, 0), v(4, 0), v(0, 4)}, triangles: 1},
+			{name: "squareWithCenter", points: []Vec[T]{v(0, 0), v(4, 0), v(4, 4), v(0, 4), v(2, 2)}, triangles: 4},
+			{name: "duplicates", points: []Vec[T]{v(0, 0), v(4, 0), v(0, 0), v(1, 5), v(4, 0)}, triangles: 1},
+			{name: "grid", points: gridPoints[T](4, 3), triangles: 2 * 3 * 2},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				tr := Delaunay(tc.points)
+				if len(tr.Triangles) != tc.triangles {
+					t.Fatalf("got %d triangles %v, want %d", len(tr.Triangles), tr.Triangles, tc.triangles)
+				}
+				assertDelaunay(t, tr)
+			})
+		}
+	})
+}
+
+func TestDelaunay_RandomPointsAreDelaunay(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	points := make([]Vec[int], 200)
+	for i := range points {
+		points[i] = NewVec(rng.IntN(1000), rng.IntN(1000))
+	}
+	tr := Delaunay(points)
+	assertDelaunay(t, tr)
+
+	// suma pól trójkątów równa się polu otoczki wypukłej
+	var area float64
+	for _, tri := range tr.Triangles {
+		area += NewPolygon(points[tri[0]], points[tri[1]], points[tri[2]]).SignedArea()
+	}
+	if hull := ConvexHull(points).Area(); math.Abs(area-hull) > eps {
+		t.Errorf("triangles cover %v, hull area is %v", area, hull)
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	runVoronoiTest[int](t, "int")
+	runVoronoiTest[uint32](t, "uint32")
+	runVoronoiTest[float64](t, "float64")
+}
+
+func runVoronoiTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		viewport := NewAABB(NewVec(T(0), T(0)), NewVec(T(10), T(10)))
+
+		two := Voronoi([]Vec[T]{NewVec(T(2), T(5)), NewVec(T(8), T(5))}, viewport)
+		wantLeft := NewPolygonFromAABB(NewAABB(NewVec(T(0), T(0)), NewVec(T(5), T(10))))
+		if got := two[0]; got.Area() != 50 || got.BoundingAABB() != wantLeft.BoundingAABB() {
+			t.Errorf("left cell = %v, want %v", got, wantLeft)
+		}
+		if got := two[1].Area(); got != 50 {
+			t.Errorf("right cell area = %v, want 50", got)
+		}
+
+		corners := []Vec[T]{
+			NewVec(T(2), T(2)), NewVec(T(8), T(2)), NewVec(T(8), T(8)), NewVec(T(2), T(8)), NewVec(T(5), T(5)),
+		}
+		cells := Voronoi(corners, viewport)
+		var total float64
+		for i, cell := range cells {
+			if !cell.IntersectsVec(corners[i]) {
+				t.Errorf("cell %v does not contain its site %v", cell, corners[i])
+			}
+			if cell.SignedArea() <= 0 {
+				t.Errorf("cell %v should have positive winding", cell)
+			}
+			total += cell.Area()
+		}
+		if total != 100 {
+			t.Errorf("cells cover %v, want 100", total)
+		}
+	})
+}
+
+func gridPoints[T Numeric](cols, rows int) []Vec[T] {
+	var points []Vec[T]
+	for y := range rows {
+		for x := range cols {
+			points = append(points, NewVec(T(3*x), T(3*y)))
+		}
+	}
+	return points
+}
+
+func assertDelaunay[T Numeric](t *testing.T, tr Triangulation[T]) {
+	t.Helper()
+	for _, tri := range tr.Triangles {
+		a, b, c := tr.Points[tri[0]], tr.Points[tri[1]], tr.Points[tri[2]]
+		if Orient2D(a, b, c) <= 0 {
+			t.Fatalf("triangle %v is not positively wound", tri)
+		}
+		for i, p := range tr.Points {
+			if i == tri[0] || i == tri[1] || i == tri[2] {
+				continue
+			}
+			if InCircle(a, b, c, p) > 0 {
+				t.Fatalf("point %v lies inside circumcircle of %v %v %v", p, a, b, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package geom
+
+import "math"
+
+// DistanceMetric turns the per-axis gap between two shapes into a distance.
+// The gap components are never negative; Manhattan, Chebyshev,
+// EuclideanSquared and Euclidean are the ready-made metrics.
+type DistanceMetric[T Numeric] func(gap Vec[T]) T
+
+// Manhattan returns gap.X + gap.Y, the number of orthogonal grid steps.
+func Manhattan[T Numeric](gap Vec[T]) T { return gap.X + gap.Y }
+
+// Chebyshev returns the larger of gap.X and gap.Y, the number of grid steps
+// when diagonal moves cost the same as orthogonal ones.
+func Chebyshev[T Numeric](gap Vec[T]) T { return max(gap.X, gap.Y) }
+
+// EuclideanSquared returns gap.X² + gap.Y², which orders distances like
+// Euclidean without a square root. Integer results can overflow for gaps
+// beyond the square root of the type's range.
+func EuclideanSquared[T Numeric](gap Vec[T]) T { return VectorMathByType[T]().Dot(gap, gap) }
+
+// Euclidean returns the straight-line length of gap, rounded up for integer
+// types like Vec.Length.
+func Euclidean[T Numeric](gap Vec[T]) T { return VectorMathByType[T]().Length(gap) }
+
+// PointDistance returns the distance between a and b under metric. Like every
+// function in this file it reads unsigned components as signed, so for uint32
+// the gap between 0xFFFF_FFFF and 1 is 2.
+func PointDistance[T Numeric](a, b Vec[T], metric DistanceMetric[T]) T {
+	return metric(Vec[T]{
+		signedAxisGap(a.X, a.X, b.X, b.X),
+		signedAxisGap(a.Y, a.Y, b.Y, b.Y),
+	})
+}
+
+// PointAABBDistance returns the distance from v to the nearest point of box
+// under metric; it is zero when v lies in box or on its boundary.
+func PointAABBDistance[T Numeric](v Vec[T], box AABB[T], metric DistanceMetric[T]) T {
+	return metric(Vec[T]{
+		signedAxisGap(v.X, v.X, box.TopLeft.X, box.BottomRight.X),
+		signedAxisGap(v.Y, v.Y, box.TopLeft.Y, box.BottomRight.Y),
+	})
+}
+
+// AABBDistance returns the distance between the nearest points of a and b
+// under metric; it is zero when the boxes overlap or touch.
+func AABBDistance[T Numeric](a, b AABB[T], metric DistanceMetric[T]) T {
+	return metric(Vec[T]{
+		signedAxisGap(a.TopLeft.X, a.BottomRight.X, b.TopLeft.X, b.BottomRight.X),
+		signedAxisGap(a.TopLeft.Y, a.BottomRight.Y, b.TopLeft.Y, b.BottomRight.Y),
+	})
+}
+
+// signedAxisGap is axisDistance1D with unsigned bounds read as signed. Once the
+// order is settled on the signed reading, the wrapping difference in T is the
+// exact gap, since it never exceeds the type's range.
+func signedAxisGap[T Numeric](aMin, aMax, bMin, bMax T) T {
+	switch {
+	case signedLess(aMax, bMin):
+		return bMin - aMax
+	case signedLess(bMax, aMin):
+		return aMin - bMax
+	}
+	return 0
+}
+
+// PointSegmentDistance returns the distance from v to the nearest point of s
+// under metric. That point is looked for at the ends of s, where the gap to v
+// is axis-aligned or diagonal, and at the orthogonal projection of v, which
+// covers the minimum of each ready-made metric. Integer gaps are rounded up
+// per axis, so a point off the segment never reads as touching it.
+func PointSegmentDistance[T Numeric](v Vec[T], s Segment[T], metric DistanceMetric[T]) T {
+	a, d := s.floatForm()
+	return segmentMetricDistance(signedFloat64Vec(v), a, d, metric)
+}
+
+// SegmentAABBDistance returns the distance between the nearest points of s and
+// box under metric; it is zero when the segment enters or touches the box. The
+// nearest points pair an end of s with box or a corner of box with s, and the
+// corner cases are measured like PointSegmentDistance.
+func SegmentAABBDistance[T Numeric](s Segment[T], box AABB[T], metric DistanceMetric[T]) T {
+	a, d := s.floatForm()
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+	if segmentBoxDistance(a, a.Add(d), minV, maxV) == 0 {
+		return 0
+	}
+	dist := min(PointAABBDistance(s.A, box, metric), PointAABBDistance(s.B, box, metric))
+	for _, corner := range [4]Vec[float64]{minV, {maxV.X, minV.Y}, maxV, {minV.X, maxV.Y}} {
+		dist = min(dist, segmentMetricDistance(corner, a, d, metric))
+	}
+	return dist
+}
+
+// segmentMetricDistance returns the smallest metric value of the gap between p
+// and the segment from a along d over the candidate parameters listed in
+// PointSegmentDistance.
+func segmentMetricDistance[T Numeric](p, a, d Vec[float64], metric DistanceMetric[T]) T {
+	w := p.Sub(a)
+	params := []float64{0, 1}
+	// luka zerowa w X lub Y, luka po przekątnej i rzut prostopadły
+	for _, frac := range [][2]float64{
+		{w.X, d.X}, {w.Y, d.Y}, {w.X - w.Y, d.X - d.Y}, {w.X + w.Y, d.X + d.Y}, {dotFloat64(w, d), dotFloat64(d, d)},
+	} {
+		if frac[1] != 0 {
+			params = append(params, max(0, min(1, frac[0]/frac[1])))
+		}
+	}
+
+	var dist T
+	for i, t := range params {
+		gap := Vec[T]{
+			lengthTo[T](math.Abs(a.X + d.X*t - p.X)),
+			lengthTo[T](math.Abs(a.Y + d.Y*t - p.Y)),
+		}
+		if m := metric(gap); i == 0 || m < dist {
+			dist = m
+		}
+	}
+	return dist
+}
@@ -0,0 +1,138 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceMetrics(t *testing.T) {
+	runDistanceMetricsTest[int](t, "int")
+	runDistanceMetricsTest[uint32](t, "uint32")
+	runDistanceMetricsTest[float64](t, "float64")
+}
+
+func runDistanceMetricsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		metrics := []struct {
+			name   string
+			metric DistanceMetric[T]
+			gap34  T
+		}{
+			{name: "Manhattan", metric: Manhattan[T], gap34: 7},
+			{name: "Chebyshev", metric: Chebyshev[T], gap34: 4},
+			{name: "EuclideanSquared", metric: EuclideanSquared[T], gap34: 25},
+			{name: "Euclidean", metric: Euclidean[T], gap34: 5},
+		}
+		box := NewAABB(v(4, 6), v(8, 9))
+
+		for _, m := range metrics {
+			t.Run(m.name, func(t *testing.T) {
+				// każdy przypadek sprowadza się do luki (3,4) albo do zera
+				testCases := []struct {
+					name     string
+					got      T
+					expected T
+				}{
+					{name: "pointPoint", got: PointDistance(v(1, 2), v(4, 6), m.metric), expected: m.gap34},
+					{name: "pointPointReversed", got: PointDistance(v(4, 6), v(1, 2), m.metric), expected: m.gap34},
+					{name: "pointAABB", got: PointAABBDistance(v(1, 2), box, m.metric), expected: m.gap34},
+					{name: "pointAABBBeyond", got: PointAABBDistance(v(11, 13), box, m.metric), expected: m.gap34},
+					{name: "pointInsideAABB", got: PointAABBDistance(v(5, 7), box, m.metric), expected: 0},
+					{name: "aabbAABB", got: AABBDistance(NewAABB(v(0, 0), v(1, 2)), box, m.metric), expected: m.gap34},
+					{name: "aabbTouching", got: AABBDistance(NewAABB(v(0, 0), v(4, 6)), box, m.metric), expected: 0},
+				}
+				for _, tc := range testCases {
+					t.Run(tc.name, func(t *testing.T) {
+						if tc.got != tc.expected {
+							t.Errorf("expected %v, got %v", tc.expected, tc.got)
+						}
+					})
+				}
+			})
+		}
+
+		if got := Euclidean(v(1, 1)); !isFloating[T]() && got != 2 {
+			t.Errorf("expected integer Euclidean to round up to 2, got %v", got)
+		}
+	})
+}
+
+func TestDistanceMetrics_UnsignedBelowZero(t *testing.T) {
+	// 0xFFFF_FFFF to -1, więc odległości liczymy jak dla int32
+	minusOne := uint32(0xFFFF_FFFF)
+	box := NewAABB(NewVec[uint32](1, 0), NewVec[uint32](3, 2))
+	testCases := []struct {
+		name     string
+		got      uint32
+		expected uint32
+	}{
+		{name: "pointPoint", got: PointDistance(NewVec(minusOne, 0), NewVec[uint32](1, 0), Manhattan[uint32]), expected: 2},
+		{name: "pointPointReversed", got: PointDistance(NewVec[uint32](1, 0), NewVec(minusOne, 0), Manhattan[uint32]), expected: 2},
+		{name: "pointAABB", got: PointAABBDistance(NewVec(minusOne, minusOne), box, Chebyshev[uint32]), expected: 2},
+		{name: "aabbAABB", got: AABBDistance(NewAABB(NewVec(minusOne-3, 0), NewVec(minusOne, 1)), box, Manhattan[uint32]), expected: 2},
+		{name: "segmentAABB", got: SegmentAABBDistance(NewSegment(NewVec(minusOne, 0), NewVec(minusOne, 2)), box, Manhattan[uint32]), expected: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestSegmentDistanceMetrics(t *testing.T) {
+	runSegmentDistanceMetricsTest[int](t, "int")
+	runSegmentDistanceMetricsTest[uint32](t, "uint32")
+	runSegmentDistanceMetricsTest[float64](t, "float64")
+}
+
+func runSegmentDistanceMetricsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		// luka (2,2) do przekątnej i (1,1) od narożnika pudełka mierzy każda metryka inaczej
+		metrics := []struct {
+			name   string
+			metric DistanceMetric[T]
+			gap34  T
+			gap22  T
+			gap11  T
+		}{
+			{name: "Manhattan", metric: Manhattan[T], gap34: 7, gap22: 4, gap11: 2},
+			{name: "Chebyshev", metric: Chebyshev[T], gap34: 4, gap22: 2, gap11: 1},
+			{name: "EuclideanSquared", metric: EuclideanSquared[T], gap34: 25, gap22: 8, gap11: 2},
+			{name: "Euclidean", metric: Euclidean[T], gap34: 5, gap22: lengthTo[T](math.Sqrt(8)), gap11: lengthTo[T](math.Sqrt2)},
+		}
+		horizontal := NewSegment(v(0, 0), v(6, 0))
+		diagonal := NewSegment(v(0, 0), v(4, 4))
+		box := NewAABB(v(4, 6), v(8, 9))
+
+		for _, m := range metrics {
+			t.Run(m.name, func(t *testing.T) {
+				testCases := []struct {
+					name     string
+					got      T
+					expected T
+				}{
+					{name: "pointBesideEnd", got: PointSegmentDistance(v(9, 4), horizontal, m.metric), expected: m.gap34},
+					{name: "pointAboveMiddle", got: PointSegmentDistance(v(3, 4), horizontal, m.metric), expected: m.metric(v(0, 4))},
+					{name: "pointOnSegment", got: PointSegmentDistance(v(2, 0), horizontal, m.metric), expected: 0},
+					{name: "pointBesideDiagonal", got: PointSegmentDistance(v(4, 0), diagonal, m.metric), expected: m.gap22},
+					{name: "degenerateSegment", got: PointSegmentDistance(v(1, 2), NewSegment(v(4, 6), v(4, 6)), m.metric), expected: m.gap34},
+					{name: "segmentEndNearBox", got: SegmentAABBDistance(NewSegment(v(0, 2), v(1, 2)), box, m.metric), expected: m.gap34},
+					{name: "segmentPassesCorner", got: SegmentAABBDistance(NewSegment(v(0, 8), v(8, 0)), box, m.metric), expected: m.gap11},
+					{name: "segmentCrossesBox", got: SegmentAABBDistance(NewSegment(v(0, 7), v(10, 7)), box, m.metric), expected: 0},
+					{name: "segmentTouchesBox", got: SegmentAABBDistance(NewSegment(v(0, 6), v(4, 6)), box, m.metric), expected: 0},
+				}
+				for _, tc := range testCases {
+					t.Run(tc.name, func(t *testing.T) {
+						if math.Abs(signedFloat64(tc.got)-signedFloat64(tc.expected)) > eps {
+							t.Errorf("expected %v, got %v", tc.expected, tc.got)
+						}
+					})
+				}
+			})
+		}
+	})
+}
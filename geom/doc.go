@@ -1,7 +1,41 @@
 // Package geom provides generic 2D geometry primitives shared across the GOK
 // modules. It defines Vec[T] with numeric constraints plus vector operations
-// (add, subtract, clamp, wrap) that are specialised per numeric kind via
-// VectorMath. AABB supplies axis-aligned bounding boxes with containment,
-// intersection, and quad-splitting helpers that higher-level packages wrap in
-// plane-aware types.
+// (add, subtract, dot/cross products, normalise, rotate, project, reflect,
+// clamp, wrap, overflow-checked and saturating add/sub) that are specialised
+// per numeric kind via VectorMath. AABB supplies axis-aligned bounding boxes
+// with containment, intersection, penetration (minimum translation vector) and
+// splitting helpers (quadrants, grids, n tiles) that always cover the parent
+// exactly; higher-level packages wrap them in plane-aware types. PointDistance,
+// PointAABBDistance, PointSegmentDistance, SegmentAABBDistance and AABBDistance
+// measure gaps under the Manhattan, Chebyshev, EuclideanSquared or Euclidean
+// metric.
+//
+// Beyond boxes, Segment adds line segments with intersection, AABB clipping and
+// closest-point queries; Ray and RayAABB cast slab-based rays and SweepAABB
+// finds the time of impact of moving boxes. Circle adds round shapes with
+// overlap, containment and penetration tests, while OBB (rotated boxes) and
+// Capsule (segments with a radius) test against each other, circles and AABBs
+// and report a BoundingAABB for spatial indexing. Polygon adds area, centroid,
+// winding and separating-axis overlap tests, and ConvexHull builds one around a
+// point set. BoundsOf, CentroidOf, CovarianceOf, PrincipalAxesOf and MinAreaOBB
+// summarise point sets such as clusters of entities. Region combines AABBs into
+// rectilinear areas with union, intersection and subtraction, and PackMaxRects
+// and PackSkyline pack sizes into a container box. Delaunay triangulates point
+// sets and Voronoi derives viewport-clipped cells from the triangulation, while
+// Triangulate ear-clips polygons with holes. Polylines can be thinned with the
+// Douglas–Peucker and Visvalingam–Whyatt simplifiers, and Affine maps vectors
+// and boxes between coordinate frames. QuadraticBezier, CubicBezier and
+// CatmullRom evaluate curves with arc-length parameterisation, adaptive
+// flattening and bounds taken from the curve's extrema. GridCells walks the
+// grid cells a line crosses, as a supercover or a thin Bresenham line, for line
+// of sight and tile raycasts.
+//
+// Vec, AABB and the shapes encode to text and JSON, and Geometry converts them
+// to and from WKT and GeoJSON for exchange with GIS tools.
+//
+// Fixed is a Q16.16 fixed-point Numeric whose FixedVectorMath uses integer
+// arithmetic only, giving bit-identical results for lockstep simulations.
+//
+// The exact Orient2D and InCircle predicates back these shape queries so nearly
+// collinear input stays consistent.
 package geom
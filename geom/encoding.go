@@ -0,0 +1,226 @@
+package geom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFormat is wrapped by every error returned while decoding text, JSON,
+// WKT or GeoJSON.
+var ErrInvalidFormat = errors.New("geom: invalid format")
+
+// Vec and AABB encode as text in the same form String prints, "(x,y)" and
+// "{(x,y) (x,y)}", so values can be logged and parsed back. In JSON a Vec is a
+// two-element array, which is also a GeoJSON position, and an AABB is an object
+// with topLeft and bottomRight; the other shapes get their JSON from field tags.
+//
+// Numbers use the shortest form that parses back exactly. Fixed is written as
+// its decimal value, and unsigned components as stored, although a leading
+// minus sign is accepted and stored in two's complement.
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Vec[T]) MarshalText() ([]byte, error) {
+	return appendVecText(nil, v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Vec[T]) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	inner, okPrefix := strings.CutPrefix(s, "(")
+	inner, okSuffix := strings.CutSuffix(inner, ")")
+	if !okPrefix || !okSuffix {
+		return formatError("vector %q: want (x,y)", s)
+	}
+	xs, ys, ok := strings.Cut(inner, ",")
+	if !ok {
+		return formatError("vector %q: want (x,y)", s)
+	}
+	x, err := parseNumber[T](xs)
+	if err != nil {
+		return err
+	}
+	y, err := parseNumber[T](ys)
+	if err != nil {
+		return err
+	}
+	*v = Vec[T]{x, y}
+	return nil
+}
+
+// MarshalJSON encodes v as [x, y].
+func (v Vec[T]) MarshalJSON() ([]byte, error) {
+	b := append([]byte{'['}, appendNumber(nil, v.X)...)
+	b = append(b, ',')
+	return append(appendNumber(b, v.Y), ']'), nil
+}
+
+// UnmarshalJSON decodes [x, y]; positions with more than two elements are rejected.
+func (v *Vec[T]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || len(raw) != 2 {
+		return formatError("vector %s: want [x, y]", data)
+	}
+	x, err := parseNumber[T](string(raw[0]))
+	if err != nil {
+		return err
+	}
+	y, err := parseNumber[T](string(raw[1]))
+	if err != nil {
+		return err
+	}
+	*v = Vec[T]{x, y}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (ab AABB[T]) MarshalText() ([]byte, error) {
+	b := appendVecText([]byte{'{'}, ab.TopLeft)
+	b = appendVecText(append(b, ' '), ab.BottomRight)
+	return append(b, '}'), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (ab *AABB[T]) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	inner, okPrefix := strings.CutPrefix(s, "{")
+	inner, okSuffix := strings.CutSuffix(inner, "}")
+	if !okPrefix || !okSuffix {
+		return formatError("box %q: want {(x,y) (x,y)}", s)
+	}
+	first, second, ok := strings.Cut(inner, ")")
+	if !ok {
+		return formatError("box %q: want {(x,y) (x,y)}", s)
+	}
+	var box AABB[T]
+	if err := box.TopLeft.UnmarshalText([]byte(first + ")")); err != nil {
+		return err
+	}
+	if err := box.BottomRight.UnmarshalText([]byte(second)); err != nil {
+		return err
+	}
+	*ab = box
+	return nil
+}
+
+// aabbJSON gives AABB its JSON field names; without it encoding/json would
+// fall back to MarshalText.
+type aabbJSON[T Numeric] struct {
+	TopLeft     Vec[T] `json:"topLeft"`
+	BottomRight Vec[T] `json:"bottomRight"`
+}
+
+// MarshalJSON encodes ab as {"topLeft": [x, y], "bottomRight": [x, y]}.
+func (ab AABB[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(aabbJSON[T](ab))
+}
+
+// UnmarshalJSON decodes the object written by MarshalJSON.
+func (ab *AABB[T]) UnmarshalJSON(data []byte) error {
+	var box aabbJSON[T]
+	if err := json.Unmarshal(data, &box); err != nil {
+		return wrapFormatError(err)
+	}
+	*ab = AABB[T](box)
+	return nil
+}
+
+// MarshalText writes f as its decimal value, e.g. "1.5".
+func (f Fixed) MarshalText() ([]byte, error) { return appendNumber(nil, f), nil }
+
+// UnmarshalText reads a decimal value and rounds it to the nearest Fixed.
+func (f *Fixed) UnmarshalText(text []byte) error {
+	v, err := parseNumber[Fixed](string(text))
+	if err == nil {
+		*f = v
+	}
+	return err
+}
+
+// MarshalJSON writes f as a JSON number with its decimal value.
+func (f Fixed) MarshalJSON() ([]byte, error) { return f.MarshalText() }
+
+// UnmarshalJSON reads a JSON number and rounds it to the nearest Fixed.
+func (f *Fixed) UnmarshalJSON(data []byte) error { return f.UnmarshalText(data) }
+
+//-----------------------------------------------------------------------------
+
+func appendVecText[T Numeric](b []byte, v Vec[T]) []byte {
+	b = appendNumber(append(b, '('), v.X)
+	b = appendNumber(append(b, ','), v.Y)
+	return append(b, ')')
+}
+
+// appendNumber appends x in the shortest form that parseNumber reads back exactly.
+func appendNumber[T Numeric](b []byte, x T) []byte {
+	switch v := any(x).(type) {
+	case Fixed:
+		return strconv.AppendFloat(b, v.Float64(), 'f', -1, 64)
+	case float32:
+		return strconv.AppendFloat(b, float64(v), 'g', -1, 32)
+	}
+	switch {
+	case isFloating[T]():
+		return strconv.AppendFloat(b, float64(x), 'g', -1, 64)
+	case isUnsigned[T]():
+		return strconv.AppendUint(b, uint64(x), 10)
+	}
+	return strconv.AppendInt(b, int64(x), 10)
+}
+
+// parseNumber reads a number written by appendNumber and rejects values T
+// cannot hold.
+func parseNumber[T Numeric](s string) (T, error) {
+	s = strings.TrimSpace(s)
+	var zero T
+	switch any(zero).(type) {
+	case Fixed:
+		f, err := strconv.ParseFloat(s, 64)
+		raw := math.Round(f * float64(FixedOne))
+		// NaN przechodzi przez oba porównania, więc odrzucamy go osobno
+		if err != nil || math.IsNaN(raw) || math.IsInf(raw, 0) || raw < math.MinInt32 || raw > math.MaxInt32 {
+			return zero, formatError("number %q: want a Fixed value", s)
+		}
+		return T(raw), nil
+	case float32:
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return zero, formatError("number %q: want float32", s)
+		}
+		return T(f), nil
+	}
+
+	if isFloating[T]() {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return zero, formatError("number %q: want float64", s)
+		}
+		return T(f), nil
+	}
+	if isUnsigned[T]() && !strings.HasPrefix(s, "-") {
+		u, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || uint64(T(u)) != u {
+			return zero, formatError("number %q: out of range or not an integer", s)
+		}
+		return T(u), nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || signedInt64(T(n)) != n {
+		return zero, formatError("number %q: out of range or not an integer", s)
+	}
+	return T(n), nil
+}
+
+func formatError(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{ErrInvalidFormat}, args...)...)
+}
+
+func wrapFormatError(err error) error {
+	if errors.Is(err, ErrInvalidFormat) {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+}
@@ -0,0 +1,114 @@
+package geom
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEncoding_TextAndJSONRoundTrip(t *testing.T) {
+	runEncodingRoundTripTest[int](t, "int")
+	runEncodingRoundTripTest[uint32](t, "uint32")
+	runEncodingRoundTripTest[uint8](t, "uint8")
+	runEncodingRoundTripTest[float32](t, "float32")
+	runEncodingRoundTripTest[float64](t, "float64")
+	runEncodingRoundTripTest[Fixed](t, "Fixed")
+}
+
+func runEncodingRoundTripTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		minusThree := int32(-3)
+		box := NewAABB(NewVec(T(minusThree), T(7)), NewVec(T(100), T(120)))
+
+		text, err := box.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// tekst ma być dokładnie tym, co wypisuje String()
+		if string(text) != box.String() {
+			t.Errorf("expected text %q, got %q", box.String(), text)
+		}
+		var fromText AABB[T]
+		if err := fromText.UnmarshalText([]byte(box.String())); err != nil || fromText != box {
+			t.Errorf("expected %v from String(), got %v (%v)", box, fromText, err)
+		}
+
+		data, err := json.Marshal(box)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromJSON AABB[T]
+		if err := json.Unmarshal(data, &fromJSON); err != nil || fromJSON != box {
+			t.Errorf("expected %v from %s, got %v (%v)", box, data, fromJSON, err)
+		}
+
+		circle := NewCircle(NewVec(T(1), T(2)), T(3))
+		data, err = json.Marshal(circle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromJSONCircle Circle[T]
+		if err := json.Unmarshal(data, &fromJSONCircle); err != nil || fromJSONCircle != circle {
+			t.Errorf("expected %v from %s, got %v (%v)", circle, data, fromJSONCircle, err)
+		}
+	})
+}
+
+func TestEncoding_Formats(t *testing.T) {
+	t.Run("vecJSONIsPosition", func(t *testing.T) {
+		data, _ := json.Marshal(NewVec(1.5, -2.0))
+		if string(data) != "[1.5,-2]" {
+			t.Errorf("expected [1.5,-2], got %s", data)
+		}
+	})
+	t.Run("aabbJSONObject", func(t *testing.T) {
+		data, _ := json.Marshal(NewAABB(NewVec(0, 1), NewVec(2, 3)))
+		if string(data) != `{"topLeft":[0,1],"bottomRight":[2,3]}` {
+			t.Errorf("unexpected JSON %s", data)
+		}
+	})
+	t.Run("fixedAsDecimal", func(t *testing.T) {
+		data, _ := json.Marshal(NewCircle(NewVec(FixedFromInt(1), FixedFromFloat64(0.5)), FixedFromFloat64(2.25)))
+		if string(data) != `{"center":[1,0.5],"radius":2.25}` {
+			t.Errorf("unexpected JSON %s", data)
+		}
+	})
+	t.Run("vecMapKey", func(t *testing.T) {
+		data, _ := json.Marshal(map[Vec[int]]string{NewVec(1, 2): "a"})
+		if string(data) != `{"(1,2)":"a"}` {
+			t.Errorf("unexpected JSON %s", data)
+		}
+	})
+	t.Run("negativeUnsigned", func(t *testing.T) {
+		var v Vec[uint32]
+		if err := v.UnmarshalText([]byte(" ( -1 , 2 ) ")); err != nil || v != NewVec(^uint32(0), uint32(2)) {
+			t.Errorf("expected two's complement -1, got %v (%v)", v, err)
+		}
+	})
+}
+
+func TestEncoding_Errors(t *testing.T) {
+	testCases := []struct {
+		name string
+		run  func() error
+	}{
+		{name: "vecNoParens", run: func() error { var v Vec[int]; return v.UnmarshalText([]byte("1,2")) }},
+		{name: "vecOneComponent", run: func() error { var v Vec[int]; return v.UnmarshalText([]byte("(1)")) }},
+		{name: "vecFraction", run: func() error { var v Vec[int]; return v.UnmarshalText([]byte("(1.5,2)")) }},
+		{name: "vecOverflow", run: func() error { var v Vec[uint8]; return v.UnmarshalText([]byte("(256,0)")) }},
+		{name: "vecJSONThree", run: func() error { var v Vec[float64]; return json.Unmarshal([]byte("[1,2,3]"), &v) }},
+		{name: "aabbMissingCorner", run: func() error { var b AABB[int]; return b.UnmarshalText([]byte("{(1,2)}")) }},
+		{name: "aabbJSONBadCorner", run: func() error { var b AABB[int]; return json.Unmarshal([]byte(`{"topLeft":"x"}`), &b) }},
+		{name: "fixedOutOfRange", run: func() error { var f Fixed; return f.UnmarshalText([]byte("40000")) }},
+		{name: "fixedNaN", run: func() error { var f Fixed; return f.UnmarshalText([]byte("NaN")) }},
+		{name: "fixedInf", run: func() error { var f Fixed; return f.UnmarshalText([]byte("-Inf")) }},
+		{name: "fixedVecNaN", run: func() error { var v Vec[Fixed]; return v.UnmarshalText([]byte("(nan,1)")) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.run(); !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("expected ErrInvalidFormat, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package geom
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// Fixed is a signed Q16.16 fixed-point number: the int32 raw value counts
+// 1/65536ths, giving a range of about ±32768 at a resolution of 1.5e-5.
+//
+// Addition and subtraction are plain integer operations, and FixedVectorMath
+// implements the rest of VectorMath (square roots and trigonometry included) on
+// integers only, so every result is bit-identical across platforms. That makes
+// Fixed suitable for lockstep simulations where float64 cannot guarantee it.
+//
+// Fixed also matches SignedInt through its int32. The products geom takes on
+// its own (Vec.Multiply, Region.Area) are rescaled, but the * operator on two
+// Fixed values multiplies raw units; use Mul instead.
+type Fixed int32
+
+const (
+	// FixedFracBits is the number of fractional bits in Fixed.
+	FixedFracBits = 16
+	// FixedOne is the raw value of 1.0.
+	FixedOne Fixed = 1 << FixedFracBits
+)
+
+// FixedFromInt converts n to Fixed; values outside ±32768 wrap.
+func FixedFromInt(n int) Fixed { return Fixed(n << FixedFracBits) }
+
+// FixedFromFloat64 rounds f to the nearest Fixed value. Use it to load
+// configuration, not inside the simulation step.
+func FixedFromFloat64(f float64) Fixed {
+	return Fixed(int64(math.Round(f * float64(FixedOne))))
+}
+
+// Float64 returns the exact value of f as float64.
+func (f Fixed) Float64() float64 { return float64(f) / float64(FixedOne) }
+
+// Int returns the integer part of f, rounded towards negative infinity.
+func (f Fixed) Int() int { return int(f >> FixedFracBits) }
+
+// Mul returns f*g rounded to the nearest Fixed value.
+func (f Fixed) Mul(g Fixed) Fixed { return Fixed(fixedShift(int64(f) * int64(g))) }
+
+// Div returns f/g rounded to the nearest Fixed value. It panics when g is zero.
+func (f Fixed) Div(g Fixed) Fixed {
+	return Fixed(divRoundInt64(int64(f)<<FixedFracBits, int64(g)))
+}
+
+// Sqrt returns the square root of f rounded down; negative values yield 0.
+func (f Fixed) Sqrt() Fixed {
+	if f <= 0 {
+		return 0
+	}
+	return Fixed(isqrt64(uint64(f) << FixedFracBits))
+}
+
+// String formats f as a decimal number, e.g. "1.5".
+func (f Fixed) String() string { return strconv.FormatFloat(f.Float64(), 'f', -1, 64) }
+
+//-----------------------------------------------------------------------------
+
+// FixedVectorMath is the VectorMath of Fixed vectors. Clamp, Wrap and the
+// checked/saturating operations come from SignedIntVectorMath, which already
+// treats the raw int32 correctly; everything that multiplies is rescaled.
+type FixedVectorMath struct{ SignedIntVectorMath[Fixed] }
+
+// Length rounds up to the next raw unit, like the integer implementations.
+func (m FixedVectorMath) Length(v Vec[Fixed]) Fixed {
+	n := uint64(dotInt64(toInt64Vec(v), toInt64Vec(v)))
+	r := isqrt64(n)
+	if r*r < n {
+		r++
+	}
+	return Fixed(r)
+}
+
+// Dot, Cross and DistanceSquared saturate at the ends of the Fixed range
+// instead of wrapping.
+func (m FixedVectorMath) Dot(v1, v2 Vec[Fixed]) Fixed {
+	a, b := toInt64Vec(v1), toInt64Vec(v2)
+	return fixedSumPinned(mulPinned(a.X, b.X), mulPinned(a.Y, b.Y))
+}
+
+func (m FixedVectorMath) Cross(v1, v2 Vec[Fixed]) Fixed {
+	a, b := toInt64Vec(v1), toInt64Vec(v2)
+	return fixedSumPinned(mulPinned(a.X, b.Y), -mulPinned(a.Y, b.X))
+}
+
+func (m FixedVectorMath) DistanceSquared(v1, v2 Vec[Fixed]) Fixed {
+	d := toInt64Vec(v1).Sub(toInt64Vec(v2))
+	return fixedSumPinned(mulPinned(d.X, d.X), mulPinned(d.Y, d.Y))
+}
+
+func (m FixedVectorMath) Normalize(v Vec[Fixed]) Vec[Fixed] {
+	w := toInt64Vec(v)
+	l := int64(isqrt64(uint64(dotInt64(w, w))))
+	if l == 0 {
+		return v
+	}
+	return Vec[Fixed]{
+		X: Fixed(divRoundInt64(w.X<<FixedFracBits, l)),
+		Y: Fixed(divRoundInt64(w.Y<<FixedFracBits, l)),
+	}
+}
+
+// Lerp rounds t to Fixed before interpolating.
+func (m FixedVectorMath) Lerp(v1, v2 Vec[Fixed], t float64) Vec[Fixed] {
+	k := int64(FixedFromFloat64(t))
+	a, b := toInt64Vec(v1), toInt64Vec(v2)
+	return Vec[Fixed]{
+		X: Fixed(a.X + fixedShift((b.X-a.X)*k)),
+		Y: Fixed(a.Y + fixedShift((b.Y-a.Y)*k)),
+	}
+}
+
+// Rotate reduces angle modulo 2π, rounds it to 2^-30 radians and evaluates sine
+// and cosine with CORDIC. math.Mod is exact, so the reduction is the same on
+// every platform; a NaN or infinite angle leaves v unrotated.
+func (m FixedVectorMath) Rotate(v Vec[Fixed], angle float64) Vec[Fixed] {
+	angle = math.Mod(angle, 2*math.Pi)
+	if math.IsNaN(angle) {
+		return v
+	}
+	sin, cos := cordicSinCos(int64(math.Round(math.Ldexp(angle, cordicFracBits))))
+	w := toInt64Vec(v)
+	return Vec[Fixed]{
+		X: Fixed(cordicShift(w.X*cos - w.Y*sin)),
+		Y: Fixed(cordicShift(w.X*sin + w.Y*cos)),
+	}
+}
+
+func (m FixedVectorMath) Project(v, axis Vec[Fixed]) Vec[Fixed] {
+	a := toInt64Vec(axis)
+	lenSq := dotInt64(a, a)
+	if lenSq == 0 {
+		return Vec[Fixed]{}
+	}
+	k := dotInt64(toInt64Vec(v), a)
+	return Vec[Fixed]{
+		X: Fixed(mulDivRoundInt64(a.X, k, lenSq)),
+		Y: Fixed(mulDivRoundInt64(a.Y, k, lenSq)),
+	}
+}
+
+func (m FixedVectorMath) Reflect(v, normal Vec[Fixed]) Vec[Fixed] {
+	p := m.Project(v, normal)
+	return Vec[Fixed]{v.X - 2*p.X, v.Y - 2*p.Y}
+}
+
+// Angle is computed with CORDIC and is exact to about 2^-28 radians.
+func (m FixedVectorMath) Angle(v1, v2 Vec[Fixed]) float64 {
+	a, b := toInt64Vec(v1), toInt64Vec(v2)
+	z := cordicAtan2(crossInt64(a, b), dotInt64(a, b))
+	return math.Ldexp(float64(z), -cordicFracBits)
+}
+
+//-----------------------------------------------------------------------------
+
+// fixedShift drops the extra fraction bits of a raw product, rounding half up.
+// Shifting before adding the half keeps p near math.MaxInt64 from overflowing.
+func fixedShift(p int64) int64 { return (p>>(FixedFracBits-1) + 1) >> 1 }
+
+// mulPinned returns a*b pinned to ±math.MaxInt64.
+func mulPinned(a, b int64) int64 {
+	hi, lo := bits.Mul64(absUint64(a), absUint64(b))
+	p := int64(math.MaxInt64)
+	if hi == 0 && lo <= math.MaxInt64 {
+		p = int64(lo)
+	}
+	if (a < 0) != (b < 0) {
+		return -p
+	}
+	return p
+}
+
+// fixedSumPinned rescales the sum of two raw products to Fixed, pinning it to
+// the Fixed range.
+func fixedSumPinned(p, q int64) Fixed {
+	r := fixedShift(saturatingAddSigned(p, q))
+	return Fixed(max(math.MinInt32, min(math.MaxInt32, r)))
+}
+
+// divRoundInt64 returns a/b rounded half away from zero.
+func divRoundInt64(a, b int64) int64 {
+	q, r := a/b, a%b
+	if 2*absUint64(r) >= absUint64(b) {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+// mulDivRoundInt64 returns a*b/c rounded half away from zero with a 128-bit
+// intermediate product; results that do not fit are saturated.
+func mulDivRoundInt64(a, b, c int64) int64 {
+	neg := (a < 0) != (b < 0) != (c < 0)
+	hi, lo := bits.Mul64(absUint64(a), absUint64(b))
+	d := absUint64(c)
+	if hi >= d {
+		if neg {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	q, r := bits.Div64(hi, lo, d)
+	if r >= d-r {
+		q++
+	}
+	if neg {
+		return -int64(q)
+	}
+	return int64(q)
+}
+
+// isqrt64 returns floor(sqrt(n)) using the digit-by-digit method.
+func isqrt64(n uint64) uint64 {
+	var r uint64
+	bit := uint64(1) << 62
+	for bit > n {
+		bit >>= 2
+	}
+	for bit != 0 {
+		if n >= r+bit {
+			n -= r + bit
+			r = r>>1 + bit
+		} else {
+			r >>= 1
+		}
+		bit >>= 2
+	}
+	return r
+}
+
+// CORDIC works on angles and unit values with cordicFracBits fraction bits.
+// The tables are literals so that no platform-dependent float code is involved.
+const (
+	cordicFracBits = 30
+	cordicPi       = 3373259426
+	cordicHalfPi   = 1686629713
+	cordicTwoPi    = 6746518852
+	cordicGain     = 652032874 // 1/K = ∏ cos(atan(2^-i))
+)
+
+var cordicAtanTable = [...]int64{
+	843314857, 497837829, 263043837, 133525159, 67021687, 33543516, 16775851, 8388437,
+	4194283, 2097149, 1048576, 524288, 262144, 131072, 65536, 32768,
+	16384, 8192, 4096, 2048, 1024, 512, 256, 128,
+	64, 32, 16, 8, 4, 2, 1,
+}
+
+func cordicShift(p int64) int64 { return (p + 1<<(cordicFracBits-1)) >> cordicFracBits }
+
+// cordicSinCos returns sine and cosine of theta.
+func cordicSinCos(theta int64) (sin, cos int64) {
+	theta %= cordicTwoPi
+	if theta > cordicPi {
+		theta -= cordicTwoPi
+	} else if theta < -cordicPi {
+		theta += cordicTwoPi
+	}
+	// CORDIC zbiega tylko dla |θ| ≤ π/2 – resztę odbijamy o π
+	flip := false
+	if theta > cordicHalfPi {
+		theta, flip = theta-cordicPi, true
+	} else if theta < -cordicHalfPi {
+		theta, flip = theta+cordicPi, true
+	}
+
+	x, y := int64(cordicGain), int64(0)
+	for i, atan := range cordicAtanTable {
+		if theta >= 0 {
+			x, y = x-y>>i, y+x>>i
+			theta -= atan
+		} else {
+			x, y = x+y>>i, y-x>>i
+			theta += atan
+		}
+	}
+	if flip {
+		return -y, -x
+	}
+	return y, x
+}
+
+// cordicAtan2 returns the angle of (x, y) in [-π, π].
+func cordicAtan2(y, x int64) int64 {
+	if x == 0 && y == 0 {
+		return 0
+	}
+	// skalujemy do ~2^40, żeby zmieścić wzmocnienie CORDIC i zachować precyzję
+	for absUint64(x) > 1<<40 || absUint64(y) > 1<<40 {
+		x, y = x>>1, y>>1
+	}
+	for absUint64(x) < 1<<38 && absUint64(y) < 1<<38 {
+		x, y = x<<1, y<<1
+	}
+
+	var z int64
+	if x < 0 {
+		if y >= 0 {
+			x, y, z = y, -x, cordicHalfPi
+		} else {
+			x, y, z = -y, x, -cordicHalfPi
+		}
+	}
+	for i, atan := range cordicAtanTable {
+		if y > 0 {
+			x, y = x+y>>i, y-x>>i
+			z += atan
+		} else {
+			x, y = x-y>>i, y+x>>i
+			z -= atan
+		}
+	}
+	return z
+}
@@ -0,0 +1,174 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFixed_Arithmetic(t *testing.T) {
+	half := FixedFromFloat64(0.5)
+	three := FixedFromInt(3)
+
+	testCases := []struct {
+		name     string
+		got      Fixed
+		expected Fixed
+	}{
+		{name: "fromInt", got: three, expected: 3 * FixedOne},
+		{name: "mul", got: three.Mul(half), expected: FixedFromFloat64(1.5)},
+		{name: "div", got: three.Div(FixedFromInt(4)), expected: FixedFromFloat64(0.75)},
+		{name: "divNegative", got: (-three).Div(FixedFromInt(2)), expected: FixedFromFloat64(-1.5)},
+		{name: "sqrt", got: FixedFromInt(9).Sqrt(), expected: three},
+		{name: "sqrtNegative", got: (-three).Sqrt(), expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, tc.got)
+			}
+		})
+	}
+
+	if s := FixedFromFloat64(-2.25).String(); s != "-2.25" {
+		t.Errorf("expected -2.25, got %s", s)
+	}
+	if i := FixedFromFloat64(-0.5).Int(); i != -1 {
+		t.Errorf("expected floor -1, got %d", i)
+	}
+}
+
+func TestFixedVectorMath(t *testing.T) {
+	vm := VectorMathByType[Fixed]()
+	f := FixedFromFloat64
+	v := func(x, y float64) Vec[Fixed] { return NewVec(f(x), f(y)) }
+	near := func(a, b Vec[Fixed]) bool {
+		// dopuszczamy kilka jednostek surowych błędu CORDIC i zaokrągleń
+		return math.Abs(float64(a.X-b.X)) <= 4 && math.Abs(float64(a.Y-b.Y)) <= 4
+	}
+
+	if got := vm.Length(v(3, 4)); got != f(5) {
+		t.Errorf("Length: expected 5, got %v", got)
+	}
+	if got := vm.Dot(v(1.5, 2), v(2, -0.5)); got != f(2) {
+		t.Errorf("Dot: expected 2, got %v", got)
+	}
+	if got := vm.Cross(v(1.5, 2), v(2, -0.5)); got != f(-4.75) {
+		t.Errorf("Cross: expected -4.75, got %v", got)
+	}
+	if got := vm.DistanceSquared(v(1, 1), v(4, 5)); got != f(25) {
+		t.Errorf("DistanceSquared: expected 25, got %v", got)
+	}
+	if got := vm.Normalize(v(3, 4)); got != v(0.6, 0.8) {
+		t.Errorf("Normalize: expected (0.6,0.8), got %v", got)
+	}
+	if got := vm.Lerp(v(0, 0), v(4, -8), 0.25); got != v(1, -2) {
+		t.Errorf("Lerp: expected (1,-2), got %v", got)
+	}
+	if got := vm.Project(v(3, 4), v(2, 0)); got != v(3, 0) {
+		t.Errorf("Project: expected (3,0), got %v", got)
+	}
+	if got := vm.Reflect(v(3, 4), v(0, 1)); got != v(3, -4) {
+		t.Errorf("Reflect: expected (3,-4), got %v", got)
+	}
+	for _, angle := range []float64{math.Pi / 2, math.Pi, -3 * math.Pi / 4, 5 * math.Pi} {
+		sin, cos := math.Sincos(angle)
+		want := v(2*cos-sin, 2*sin+cos)
+		if got := vm.Rotate(v(2, 1), angle); !near(got, want) {
+			t.Errorf("Rotate(%v): expected %v, got %v", angle, want, got)
+		}
+	}
+	for _, to := range []Vec[Fixed]{v(0, 1), v(-1, 0), v(-1, -1), v(1, -1)} {
+		want := math.Atan2(float64(to.Y), float64(to.X))
+		if got := vm.Angle(v(1, 0), to); math.Abs(got-want) > 1e-7 {
+			t.Errorf("Angle to %v: expected %v, got %v", to, want, got)
+		}
+	}
+	if got := vm.Wrap(v(-1, 11), v(10, 10)); got != v(9, 1) {
+		t.Errorf("Wrap: expected (9,1), got %v", got)
+	}
+	if got := vm.Clamp(v(-1, 11), v(10, 10)); got != v(0, 10) {
+		t.Errorf("Clamp: expected (0,10), got %v", got)
+	}
+}
+
+// Wartości referencyjne pilnują, że obrót daje identyczne bity na każdej platformie.
+func TestFixedVectorMath_RotateIsBitExact(t *testing.T) {
+	got := VectorMathByType[Fixed]().Rotate(NewVec(FixedFromInt(100), FixedFromInt(0)), 0.5)
+	want := NewVec[Fixed](5751325, 3141963)
+	if got != want {
+		t.Errorf("expected raw %d,%d, got raw %d,%d", want.X, want.Y, got.X, got.Y)
+	}
+}
+
+func TestFixedVectorMath_RotateLargeAngles(t *testing.T) {
+	m := VectorMathByType[Fixed]()
+	v := NewVec(FixedFromInt(100), FixedFromInt(0))
+	want := m.Rotate(v, 0.5)
+
+	if got := m.Rotate(v, 0.5+4*math.Pi); got != want {
+		t.Errorf("0.5+4π: expected raw %d,%d, got raw %d,%d", want.X, want.Y, got.X, got.Y)
+	}
+	if got := m.Rotate(v, 0.5-6*math.Pi); got != want {
+		t.Errorf("0.5-6π: expected raw %d,%d, got raw %d,%d", want.X, want.Y, got.X, got.Y)
+	}
+	// kąty poza zakresem int64 po przeskalowaniu muszą nadal dawać obrót, a nie śmieci
+	huge := m.Rotate(v, 1e300)
+	if l := m.Length(huge); l < FixedFromFloat64(99.99) || l > FixedFromFloat64(100.01) {
+		t.Errorf("1e300: expected length 100, got %v (%v)", l, huge)
+	}
+	for _, angle := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if got := m.Rotate(v, angle); got != v {
+			t.Errorf("%v: expected %v unchanged, got %v", angle, v, got)
+		}
+	}
+}
+
+func TestFixedVectorMath_ProductsSaturate(t *testing.T) {
+	m := VectorMathByType[Fixed]()
+	lo, hi := Fixed(math.MinInt32), Fixed(math.MaxInt32)
+
+	testCases := []struct {
+		name     string
+		got      Fixed
+		expected Fixed
+	}{
+		{name: "dotInRange", got: m.Dot(NewVec(FixedFromFloat64(1.5), FixedFromInt(2)), NewVec(FixedFromInt(2), -FixedOne)), expected: FixedOne},
+		{name: "dotSumOverflowsInt64", got: m.Dot(NewVec(lo, lo), NewVec(lo, lo)), expected: hi},
+		{name: "dotNegative", got: m.Dot(NewVec(lo, lo), NewVec(hi, hi)), expected: lo},
+		{name: "crossNegative", got: m.Cross(NewVec(lo, 0), NewVec(0, hi)), expected: lo},
+		{name: "crossSumOverflowsInt64", got: m.Cross(NewVec(lo, lo), NewVec(hi, lo)), expected: hi},
+		{name: "distanceSquaredAcrossRange", got: m.DistanceSquared(NewVec(lo, lo), NewVec(hi, hi)), expected: hi},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("expected raw %d, got raw %d", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestFixed_ShapesUseRealUnits(t *testing.T) {
+	square := NewPolygonFromAABB(NewAABB(NewVec[Fixed](0, 0), NewVec(FixedFromInt(2), FixedFromInt(3))))
+	if area := square.Area(); area != 6 {
+		t.Errorf("expected area 6, got %v", area)
+	}
+	seg := NewSegment(NewVec[Fixed](0, 0), NewVec(FixedFromInt(3), FixedFromInt(4)))
+	if l := seg.Length(); l != FixedFromInt(5) {
+		t.Errorf("expected length 5, got %v", l)
+	}
+
+	region := NewRegion(
+		NewAABB(NewVec[Fixed](0, 0), NewVec(FixedFromInt(2), FixedFromInt(3))),
+		NewAABB(NewVec(FixedFromInt(10), 0), NewVec(FixedFromFloat64(10.5), FixedFromInt(100))),
+	)
+	if area := region.Area(); area != FixedFromInt(56) {
+		t.Errorf("expected region area 56, got %v", area)
+	}
+
+	v := NewVec(FixedFromFloat64(1.5), FixedFromInt(-200))
+	v.Multiply(FixedFromInt(4))
+	if want := NewVec(FixedFromInt(6), FixedFromInt(-800)); v != want {
+		t.Errorf("expected %v, got %v", want, v)
+	}
+}
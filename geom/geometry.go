@@ -0,0 +1,335 @@
+package geom
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// GeometryKind selects which fields of a Geometry are set.
+type GeometryKind int
+
+const (
+	// GeometryPoint is a single position stored in Point.
+	GeometryPoint GeometryKind = iota
+	// GeometryLineString is an open path stored in Path.
+	GeometryLineString
+	// GeometryPolygon is an area stored in Outer, minus the areas in Holes.
+	GeometryPolygon
+)
+
+// Geometry is the exchange form of shapes for GIS tools: its text form is WKT
+// (POINT, LINESTRING and POLYGON) and its JSON form a GeoJSON geometry object,
+// so it can be read from and written to QGIS layers.
+//
+// Polygon rings are kept open like Polygon: the closing vertex that WKT and
+// GeoJSON repeat is added on output and dropped on input. Outer and Holes can
+// be passed straight to Triangulate.
+type Geometry[T Numeric] struct {
+	Kind  GeometryKind
+	Point Vec[T]
+	Path  []Vec[T]
+	Outer Polygon[T]
+	Holes []Polygon[T]
+}
+
+// NewPointGeometry returns v as a GeometryPoint.
+func NewPointGeometry[T Numeric](v Vec[T]) Geometry[T] {
+	return Geometry[T]{Kind: GeometryPoint, Point: v}
+}
+
+// NewLineStringGeometry returns path as a GeometryLineString.
+func NewLineStringGeometry[T Numeric](path []Vec[T]) Geometry[T] {
+	return Geometry[T]{Kind: GeometryLineString, Path: path}
+}
+
+// NewPolygonGeometry returns outer with holes as a GeometryPolygon.
+func NewPolygonGeometry[T Numeric](outer Polygon[T], holes ...Polygon[T]) Geometry[T] {
+	if len(holes) == 0 {
+		holes = nil
+	}
+	return Geometry[T]{Kind: GeometryPolygon, Outer: outer, Holes: holes}
+}
+
+// NewAABBGeometry returns box as a GeometryPolygon with its four corners.
+func NewAABBGeometry[T Numeric](box AABB[T]) Geometry[T] {
+	return NewPolygonGeometry(NewPolygonFromAABB(box))
+}
+
+// String returns the WKT form of g.
+func (g Geometry[T]) String() string { return g.WKT() }
+
+// WKT formats g as well-known text, e.g. "POLYGON ((0 0, 4 0, 4 4, 0 0))".
+// Paths without points and polygons without outer vertices are written as
+// EMPTY.
+func (g Geometry[T]) WKT() string {
+	var b []byte
+	switch g.Kind {
+	case GeometryPoint:
+		b = appendWKTPositions(append(b, "POINT "...), []Vec[T]{g.Point}, false)
+	case GeometryLineString:
+		b = append(b, "LINESTRING "...)
+		if len(g.Path) == 0 {
+			return string(append(b, "EMPTY"...))
+		}
+		b = appendWKTPositions(b, g.Path, false)
+	case GeometryPolygon:
+		b = append(b, "POLYGON "...)
+		if len(g.Outer.Vertices) == 0 {
+			return string(append(b, "EMPTY"...))
+		}
+		b = append(b, '(')
+		for i, ring := range g.rings() {
+			if i > 0 {
+				b = append(b, ", "...)
+			}
+			b = appendWKTPositions(b, ring.Vertices, true)
+		}
+		b = append(b, ')')
+	}
+	return string(b)
+}
+
+// ParseWKT reads a POINT, LINESTRING or POLYGON in well-known text. Keywords
+// are case-insensitive; Z and M coordinates and multi-geometries are rejected.
+// A Geometry has no empty point, so "POINT EMPTY" reads as the zero point and
+// is written back as "POINT (0 0)".
+func ParseWKT[T Numeric](s string) (Geometry[T], error) {
+	s = strings.TrimSpace(s)
+	keyword, body := s, ""
+	if i := strings.IndexAny(s, " \t\n("); i >= 0 {
+		keyword, body = s[:i], strings.TrimSpace(s[i:])
+	}
+	empty := strings.EqualFold(body, "EMPTY")
+
+	switch strings.ToUpper(keyword) {
+	case "POINT":
+		if empty {
+			return NewPointGeometry(Vec[T]{}), nil
+		}
+		items, err := splitWKTList(body)
+		if err != nil || len(items) != 1 {
+			return Geometry[T]{}, formatError("WKT %q: want POINT (x y)", s)
+		}
+		p, err := parseWKTPosition[T](items[0])
+		return NewPointGeometry(p), err
+	case "LINESTRING":
+		if empty {
+			return NewLineStringGeometry[T](nil), nil
+		}
+		path, err := parseWKTPositions[T](body)
+		return NewLineStringGeometry(path), err
+	case "POLYGON":
+		if empty {
+			return NewPolygonGeometry(Polygon[T]{}), nil
+		}
+		items, err := splitWKTList(body)
+		if err != nil || len(items) == 0 {
+			return Geometry[T]{}, formatError("WKT %q: want POLYGON ((x y, ...))", s)
+		}
+		rings := make([]Polygon[T], len(items))
+		for i, item := range items {
+			ring, err := parseWKTPositions[T](item)
+			if err != nil {
+				return Geometry[T]{}, err
+			}
+			rings[i] = openRing(ring)
+		}
+		return NewPolygonGeometry(rings[0], rings[1:]...), nil
+	}
+	return Geometry[T]{}, formatError("WKT %q: unsupported geometry type", s)
+}
+
+// MarshalText implements encoding.TextMarshaler with WKT.
+func (g Geometry[T]) MarshalText() ([]byte, error) { return []byte(g.WKT()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseWKT.
+func (g *Geometry[T]) UnmarshalText(text []byte) error {
+	parsed, err := ParseWKT[T](string(text))
+	if err == nil {
+		*g = parsed
+	}
+	return err
+}
+
+// geoJSON is a GeoJSON geometry object; the shape of coordinates depends on type.
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// MarshalJSON encodes g as a GeoJSON geometry object, e.g.
+// {"type":"Point","coordinates":[1,2]}.
+func (g Geometry[T]) MarshalJSON() ([]byte, error) {
+	var (
+		typ    string
+		coords any
+	)
+	switch g.Kind {
+	case GeometryPoint:
+		typ, coords = "Point", g.Point
+	case GeometryLineString:
+		typ, coords = "LineString", nonNil(g.Path)
+	case GeometryPolygon:
+		typ = "Polygon"
+		rings := [][]Vec[T]{}
+		if len(g.Outer.Vertices) > 0 {
+			for _, ring := range g.rings() {
+				rings = append(rings, closeRing(ring.Vertices))
+			}
+		}
+		coords = rings
+	}
+	raw, err := json.Marshal(coords)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(geoJSON{Type: typ, Coordinates: raw})
+}
+
+// UnmarshalJSON decodes a GeoJSON Point, LineString or Polygon geometry object.
+func (g *Geometry[T]) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseGeoJSON[T](data)
+	if err == nil {
+		*g = parsed
+	}
+	return err
+}
+
+// ParseGeoJSON reads a GeoJSON Point, LineString or Polygon geometry object.
+// Positions must have exactly two coordinates.
+func ParseGeoJSON[T Numeric](data []byte) (Geometry[T], error) {
+	var obj geoJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return Geometry[T]{}, wrapFormatError(err)
+	}
+	var err error
+	switch obj.Type {
+	case "Point":
+		var p Vec[T]
+		if err = json.Unmarshal(obj.Coordinates, &p); err == nil {
+			return NewPointGeometry(p), nil
+		}
+	case "LineString":
+		var path []Vec[T]
+		if err = json.Unmarshal(obj.Coordinates, &path); err == nil {
+			return NewLineStringGeometry(path), nil
+		}
+	case "Polygon":
+		var rings [][]Vec[T]
+		if err = json.Unmarshal(obj.Coordinates, &rings); err == nil {
+			if len(rings) == 0 {
+				return NewPolygonGeometry(Polygon[T]{}), nil
+			}
+			polys := make([]Polygon[T], len(rings))
+			for i, ring := range rings {
+				polys[i] = openRing(ring)
+			}
+			return NewPolygonGeometry(polys[0], polys[1:]...), nil
+		}
+	default:
+		return Geometry[T]{}, formatError("GeoJSON type %q: want Point, LineString or Polygon", obj.Type)
+	}
+	return Geometry[T]{}, wrapFormatError(err)
+}
+
+//-----------------------------------------------------------------------------
+
+func (g Geometry[T]) rings() []Polygon[T] {
+	return append([]Polygon[T]{g.Outer}, g.Holes...)
+}
+
+// closeRing repeats the first vertex at the end, as WKT and GeoJSON require.
+func closeRing[T Numeric](ring []Vec[T]) []Vec[T] {
+	if len(ring) == 0 || ring[0] == ring[len(ring)-1] && len(ring) > 1 {
+		return ring
+	}
+	return append(ring[:len(ring):len(ring)], ring[0])
+}
+
+// openRing drops the repeated closing vertex.
+func openRing[T Numeric](ring []Vec[T]) Polygon[T] {
+	if n := len(ring); n > 1 && ring[0] == ring[n-1] {
+		ring = ring[:n-1]
+	}
+	return Polygon[T]{Vertices: ring}
+}
+
+func nonNil[T Numeric](path []Vec[T]) []Vec[T] {
+	if path == nil {
+		return []Vec[T]{}
+	}
+	return path
+}
+
+func appendWKTPositions[T Numeric](b []byte, positions []Vec[T], closed bool) []byte {
+	if closed {
+		positions = closeRing(positions)
+	}
+	b = append(b, '(')
+	for i, p := range positions {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = appendNumber(b, p.X)
+		b = appendNumber(append(b, ' '), p.Y)
+	}
+	return append(b, ')')
+}
+
+func parseWKTPositions[T Numeric](s string) ([]Vec[T], error) {
+	items, err := splitWKTList(s)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]Vec[T], len(items))
+	for i, item := range items {
+		if positions[i], err = parseWKTPosition[T](item); err != nil {
+			return nil, err
+		}
+	}
+	return positions, nil
+}
+
+// parseWKTPosition reads "x y".
+func parseWKTPosition[T Numeric](s string) (Vec[T], error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Vec[T]{}, formatError("WKT position %q: want x y", s)
+	}
+	x, err := parseNumber[T](fields[0])
+	if err != nil {
+		return Vec[T]{}, err
+	}
+	y, err := parseNumber[T](fields[1])
+	return Vec[T]{x, y}, err
+}
+
+// splitWKTList strips the outer parentheses of s and splits its content on the
+// commas that are not nested in further parentheses.
+func splitWKTList(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, formatError("WKT %q: want a parenthesised list", s)
+	}
+	var items []string
+	depth, start := 0, 1
+	for i := 1; i < len(s)-1; i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth < 0 {
+				return nil, formatError("WKT %q: unbalanced parentheses", s)
+			}
+		case ',':
+			if depth == 0 {
+				items = append(items, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, formatError("WKT %q: unbalanced parentheses", s)
+	}
+	return append(items, strings.TrimSpace(s[start:len(s)-1])), nil
+}
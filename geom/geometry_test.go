@@ -0,0 +1,133 @@
+package geom
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGeometry_WKT(t *testing.T) {
+	square := NewPolygon(NewVec(0, 0), NewVec(10, 0), NewVec(10, 10), NewVec(0, 10))
+	hole := NewPolygon(NewVec(2, 2), NewVec(4, 2), NewVec(4, 4))
+
+	testCases := []struct {
+		name     string
+		geometry Geometry[int]
+		wkt      string
+	}{
+		{name: "point", geometry: NewPointGeometry(NewVec(1, -2)), wkt: "POINT (1 -2)"},
+		{name: "lineString", geometry: NewLineStringGeometry([]Vec[int]{{0, 0}, {5, 5}, {10, 0}}), wkt: "LINESTRING (0 0, 5 5, 10 0)"},
+		{name: "emptyLineString", geometry: NewLineStringGeometry[int](nil), wkt: "LINESTRING EMPTY"},
+		{name: "polygonWithHole", geometry: NewPolygonGeometry(square, hole),
+			wkt: "POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (2 2, 4 2, 4 4, 2 2))"},
+		{name: "aabb", geometry: NewAABBGeometry(NewAABB(NewVec(1, 2), NewVec(3, 4))),
+			wkt: "POLYGON ((1 2, 3 2, 3 4, 1 4, 1 2))"},
+		{name: "emptyPolygon", geometry: NewPolygonGeometry(Polygon[int]{}), wkt: "POLYGON EMPTY"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.geometry.WKT(); got != tc.wkt {
+				t.Errorf("expected %q, got %q", tc.wkt, got)
+			}
+			parsed, err := ParseWKT[int](tc.wkt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.WKT() != tc.wkt {
+				t.Errorf("expected round trip to %q, got %q", tc.wkt, parsed.WKT())
+			}
+		})
+	}
+
+	t.Run("emptyPoint", func(t *testing.T) {
+		g, err := ParseWKT[int]("point empty")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := NewPointGeometry(Vec[int]{}); !reflect.DeepEqual(g, expected) {
+			t.Errorf("expected %+v, got %+v", expected, g)
+		}
+	})
+
+	t.Run("lenientInput", func(t *testing.T) {
+		// zapis w stylu QGIS: małe litery, brak spacji, dodatkowe białe znaki
+		g, err := ParseWKT[float64]("Polygon((0 0,1.5 0 , 1.5 2,0 0))")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := NewPolygon(NewVec(0.0, 0.0), NewVec(1.5, 0.0), NewVec(1.5, 2.0))
+		if g.Kind != GeometryPolygon || !reflect.DeepEqual(g.Outer, expected) || len(g.Holes) != 0 {
+			t.Errorf("expected %v, got %+v", expected, g)
+		}
+	})
+}
+
+func TestGeometry_WKTErrors(t *testing.T) {
+	for _, wkt := range []string{
+		"POINT (1 2 3)",
+		"POINT Z (1 2 3)",
+		"LINESTRING (0 0, 1 1",
+		"POLYGON ((0 0, 1 1)), (2 2)",
+		"MULTIPOINT ((0 0))",
+		"POINT (a b)",
+	} {
+		t.Run(wkt, func(t *testing.T) {
+			if _, err := ParseWKT[int](wkt); !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("expected ErrInvalidFormat, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGeometry_GeoJSON(t *testing.T) {
+	runGeometryGeoJSONTest[int](t, "int")
+	runGeometryGeoJSONTest[uint32](t, "uint32")
+	runGeometryGeoJSONTest[float64](t, "float64")
+}
+
+func runGeometryGeoJSONTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		testCases := []struct {
+			name     string
+			geometry Geometry[T]
+			json     string
+		}{
+			{name: "point", geometry: NewPointGeometry(v(1, 2)), json: `{"type":"Point","coordinates":[1,2]}`},
+			{name: "lineString", geometry: NewLineStringGeometry([]Vec[T]{v(0, 0), v(3, 4)}),
+				json: `{"type":"LineString","coordinates":[[0,0],[3,4]]}`},
+			{name: "polygon", geometry: NewPolygonGeometry(NewPolygon(v(0, 0), v(4, 0), v(4, 4))),
+				json: `{"type":"Polygon","coordinates":[[[0,0],[4,0],[4,4],[0,0]]]}`},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				data, err := json.Marshal(tc.geometry)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(data) != tc.json {
+					t.Errorf("expected %s, got %s", tc.json, data)
+				}
+				var parsed Geometry[T]
+				if err := json.Unmarshal(data, &parsed); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(parsed, tc.geometry) {
+					t.Errorf("expected %+v, got %+v", tc.geometry, parsed)
+				}
+			})
+		}
+
+		for _, bad := range []string{
+			`{"type":"MultiPoint","coordinates":[[0,0]]}`,
+			`{"type":"Point","coordinates":[0,0,5]}`,
+			`{"type":"LineString","coordinates":"x"}`,
+			`[1,2]`,
+		} {
+			if _, err := ParseGeoJSON[T]([]byte(bad)); !errors.Is(err, ErrInvalidFormat) {
+				t.Errorf("%s: expected ErrInvalidFormat, got %v", bad, err)
+			}
+		}
+	})
+}
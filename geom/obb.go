@@ -0,0 +1,111 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// OBB is an oriented bounding box: a rectangle with half-sizes HalfExtents
+// around Center, turned by Rotation radians from +X towards +Y like Vec.Rotate.
+// The boundary belongs to the box, so shapes that only touch it intersect.
+//
+// Rotation is float64 whatever T is, so integer spaces can hold rotated boxes;
+// the tests are carried out in float64 with the center read as signed, like
+// Circle does.
+type OBB[T Numeric] struct {
+	Center      Vec[T]  `json:"center"`
+	HalfExtents Vec[T]  `json:"halfExtents"`
+	Rotation    float64 `json:"rotation"`
+}
+
+// NewOBB constructs an oriented box centered at center.
+func NewOBB[T Numeric](center, halfExtents Vec[T], rotation float64) OBB[T] {
+	return OBB[T]{Center: center, HalfExtents: halfExtents, Rotation: rotation}
+}
+
+// NewOBBFromAABB returns box as an oriented box without rotation. For integer
+// types the center and half-extents are rounded, so boxes with an odd size are
+// only approximated.
+func NewOBBFromAABB[T Numeric](box AABB[T]) OBB[T] {
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+	return OBB[T]{
+		Center:      roundToVec[T](Vec[float64]{(minV.X + maxV.X) / 2, (minV.Y + maxV.Y) / 2}),
+		HalfExtents: roundToVec[T](Vec[float64]{(maxV.X - minV.X) / 2, (maxV.Y - minV.Y) / 2}),
+	}
+}
+
+// String formats the box as "{(x,y) ±(hx,hy) @rotation}".
+func (o OBB[T]) String() string {
+	return fmt.Sprintf("{%v ±%v @%v}", o.Center, o.HalfExtents, o.Rotation)
+}
+
+// BoundingAABB returns the smallest AABB enclosing the box. Integer bounds are
+// rounded outwards, so the result can go straight into a grid index.
+func (o OBB[T]) BoundingAABB() AABB[T] {
+	corners := o.corners()
+	bounds := boundingAABBOf(corners[:]...)
+	return NewAABB(
+		Vec[T]{floorTo[T](bounds.TopLeft.X), floorTo[T](bounds.TopLeft.Y)},
+		Vec[T]{ceilTo[T](bounds.BottomRight.X), ceilTo[T](bounds.BottomRight.Y)},
+	)
+}
+
+// ContainsVec reports whether v lies inside the box or on its boundary.
+func (o OBB[T]) ContainsVec(v Vec[T]) bool {
+	p := o.toLocal(signedFloat64Vec(v))
+	h := o.halfExtents()
+	return math.Abs(p.X) <= h.X+eps && math.Abs(p.Y) <= h.Y+eps
+}
+
+// Intersects reports whether the box overlaps or touches box, using the
+// separating axis theorem.
+func (o OBB[T]) Intersects(box AABB[T]) bool {
+	a, b := o.corners(), aabbCornersFloat64(box)
+	return convexOverlap(a[:], b[:])
+}
+
+// IntersectsOBB reports whether o and other overlap or touch, using the
+// separating axis theorem.
+func (o OBB[T]) IntersectsOBB(other OBB[T]) bool {
+	a, b := o.corners(), other.corners()
+	return convexOverlap(a[:], b[:])
+}
+
+// IntersectsCircle reports whether the box overlaps or touches c. The circle
+// center is moved into the box frame and clamped to the box there.
+func (o OBB[T]) IntersectsCircle(c Circle[T]) bool {
+	p := o.toLocal(signedFloat64Vec(c.Center))
+	h := o.halfExtents()
+	closest := Vec[float64]{min(max(p.X, -h.X), h.X), min(max(p.Y, -h.Y), h.Y)}
+	return distSqFloat64(p, closest) <= c.radiusSq()+eps
+}
+
+// IntersectsCapsule reports whether the box overlaps or touches c.
+func (o OBB[T]) IntersectsCapsule(c Capsule[T]) bool {
+	return c.IntersectsOBB(o)
+}
+
+// corners returns the box corners in order around the boundary.
+func (o OBB[T]) corners() [4]Vec[float64] {
+	h := o.halfExtents()
+	local := [4]Vec[float64]{{-h.X, -h.Y}, {h.X, -h.Y}, {h.X, h.Y}, {-h.X, h.Y}}
+	c := signedFloat64Vec(o.Center)
+	sin, cos := math.Sincos(o.Rotation)
+	for i, p := range local {
+		local[i] = Vec[float64]{c.X + p.X*cos - p.Y*sin, c.Y + p.X*sin + p.Y*cos}
+	}
+	return local
+}
+
+// toLocal moves p into the box frame, where the box is [-h, h] on both axes.
+func (o OBB[T]) toLocal(p Vec[float64]) Vec[float64] {
+	c := signedFloat64Vec(o.Center)
+	d := Vec[float64]{p.X - c.X, p.Y - c.Y}
+	sin, cos := math.Sincos(o.Rotation)
+	return Vec[float64]{d.X*cos + d.Y*sin, -d.X*sin + d.Y*cos}
+}
+
+func (o OBB[T]) halfExtents() Vec[float64] {
+	h := signedFloat64Vec(o.HalfExtents)
+	return Vec[float64]{math.Abs(h.X), math.Abs(h.Y)}
+}
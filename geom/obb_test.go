@@ -0,0 +1,77 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOBB_Intersections(t *testing.T) {
+	runOBBIntersectionsTest[int](t, "int")
+	runOBBIntersectionsTest[uint32](t, "uint32")
+	runOBBIntersectionsTest[float64](t, "float64")
+}
+
+func runOBBIntersectionsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		// kwadrat 2x2 obrócony o 45° sięga na odległość √2 ≈ 1.41 od środka
+		diamond := NewOBB(v(10, 10), v(1, 1), math.Pi/4)
+
+		testCases := []struct {
+			name     string
+			got      bool
+			expected bool
+		}{
+			{name: "containsCenter", got: diamond.ContainsVec(v(10, 10)), expected: true},
+			{name: "containsTip", got: diamond.ContainsVec(v(11, 10)), expected: true},
+			{name: "missesCorner", got: diamond.ContainsVec(v(11, 11)), expected: false},
+			{name: "aabbNearTip", got: diamond.Intersects(NewAABB(v(11, 9), v(13, 11))), expected: true},
+			{name: "aabbInCornerGap", got: diamond.Intersects(NewAABB(v(11, 11), v(13, 13))), expected: false},
+			{name: "aabbTouchingUnrotated", got: NewOBB(v(10, 10), v(2, 1), 0).Intersects(NewAABB(v(12, 0), v(14, 20))), expected: true},
+			{name: "obbCrossed", got: diamond.IntersectsOBB(NewOBB(v(10, 10), v(5, 0), 0)), expected: true},
+			{name: "obbApart", got: diamond.IntersectsOBB(NewOBB(v(13, 13), v(1, 1), math.Pi/4)), expected: false},
+			{name: "obbTipToTip", got: diamond.IntersectsOBB(NewOBB(v(12, 10), v(1, 1), math.Pi/4)), expected: true},
+			{name: "circleNearFace", got: diamond.IntersectsCircle(NewCircle(v(12, 12), T(2))), expected: true},
+			{name: "circleApart", got: diamond.IntersectsCircle(NewCircle(v(13, 13), T(2))), expected: false},
+			{name: "capsuleThrough", got: diamond.IntersectsCapsule(NewCapsule(v(0, 10), v(20, 10), T(0))), expected: true},
+			{name: "capsuleAbove", got: diamond.IntersectsCapsule(NewCapsule(v(0, 13), v(20, 13), T(1))), expected: false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if tc.got != tc.expected {
+					t.Errorf("expected %v, got %v", tc.expected, tc.got)
+				}
+			})
+		}
+	})
+}
+
+func TestOBB_BoundingAABB(t *testing.T) {
+	runOBBBoundingAABBTest[int](t, "int")
+	runOBBBoundingAABBTest[uint32](t, "uint32")
+	runOBBBoundingAABBTest[float64](t, "float64")
+}
+
+func runOBBBoundingAABBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		if got := NewOBB(v(10, 10), v(4, 2), math.Pi/2).BoundingAABB(); got != NewAABB(v(8, 6), v(12, 14)) {
+			t.Errorf("quarter turn: expected {(8,6) (12,14)}, got %v", got)
+		}
+
+		got := NewOBB(v(10, 10), v(1, 1), math.Pi/4).BoundingAABB()
+		want := 10 - math.Sqrt2
+		if isFloating[T]() {
+			if math.Abs(signedFloat64(got.TopLeft.X)-want) > 1e-9 {
+				t.Errorf("expected exact bounds from %v, got %v", want, got)
+			}
+		} else if got != NewAABB(v(8, 8), v(12, 12)) {
+			// granice całkowite zaokrąglane na zewnątrz
+			t.Errorf("expected outward-rounded {(8,8) (12,12)}, got %v", got)
+		}
+
+		if got := NewOBBFromAABB(NewAABB(v(2, 4), v(8, 10))); got != NewOBB(v(5, 7), v(3, 3), 0) {
+			t.Errorf("from AABB: expected {(5,7) ±(3,3) @0}, got %v", got)
+		}
+	})
+}
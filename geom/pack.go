@@ -0,0 +1,264 @@
+package geom
+
+import "slices"
+
+// Packed is one size placed by PackMaxRects or PackSkyline.
+type Packed[T Numeric] struct {
+	// Index is the position of the size in the input slice.
+	Index int
+	// Box is where the size landed inside the container.
+	Box AABB[T]
+	// Rotated reports whether the size was turned by 90°, so Box is Y by X.
+	Rotated bool
+}
+
+// PackResult lists what a packer placed and what it could not.
+type PackResult[T Numeric] struct {
+	// Placed holds the placed sizes ordered by Index.
+	Placed []Packed[T]
+	// Unfit holds the indices of sizes that found no room, in ascending order.
+	Unfit []int
+}
+
+// The packers below place sizes (X is the width, Y the height) into container
+// without overlaps, packing them towards its top-left corner. Sizes are tried
+// from the largest side down, which packs far tighter than input order; sizes
+// with a negative side never fit. With allowRotation a size may be turned by 90°
+// when that scores better or is the only way it fits.
+
+// PackMaxRects packs sizes with the MaxRects algorithm and the best short side
+// fit rule: it keeps every maximal free rectangle and puts each size where the
+// smaller of its leftover sides is the least. It is slower than PackSkyline but
+// wastes less space, which suits sprite atlases.
+func PackMaxRects[T Numeric](container AABB[T], sizes []Vec[T], allowRotation bool) PackResult[T] {
+	free := []AABB[T]{{BottomRight: containerSize(container)}}
+	return pack(container, sizes, allowRotation, func(w, h T) (Vec[T], T, T, bool) {
+		return maxRectsFind(free, w, h)
+	}, func(placed AABB[T]) {
+		free = maxRectsSplit(free, placed)
+	})
+}
+
+// PackSkyline packs sizes with the skyline algorithm: it tracks only the top
+// edge of the packed area and puts each size where its far edge stays lowest.
+// It is fast and keeps layouts compact row by row, which suits room layouts.
+func PackSkyline[T Numeric](container AABB[T], sizes []Vec[T], allowRotation bool) PackResult[T] {
+	size := containerSize(container)
+	line := []skylineSegment[T]{{width: size.X}}
+	return pack(container, sizes, allowRotation, func(w, h T) (Vec[T], T, T, bool) {
+		return skylineFind(line, size, w, h)
+	}, func(placed AABB[T]) {
+		line = skylineInsert(line, placed)
+	})
+}
+
+// pack drives both packers. find returns the best local position for a w x h
+// size with its primary and secondary scores (lower is better); place commits
+// a chosen local box.
+func pack[T Numeric](
+	container AABB[T],
+	sizes []Vec[T],
+	allowRotation bool,
+	find func(w, h T) (pos Vec[T], primary, secondary T, ok bool),
+	place func(placed AABB[T]),
+) PackResult[T] {
+	var zero T
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		sa, sb := sizes[a], sizes[b]
+		if c := compareDesc(max(sa.X, sa.Y), max(sb.X, sb.Y)); c != 0 {
+			return c
+		}
+		return compareDesc(min(sa.X, sa.Y), min(sb.X, sb.Y))
+	})
+
+	var result PackResult[T]
+	for _, i := range order {
+		w, h := sizes[i].X, sizes[i].Y
+		if w < zero || h < zero {
+			result.Unfit = append(result.Unfit, i)
+			continue
+		}
+		pos, primary, secondary, ok := find(w, h)
+		rotated := false
+		if allowRotation && w != h {
+			rPos, rPrimary, rSecondary, rOk := find(h, w)
+			if rOk && (!ok || rPrimary < primary || (rPrimary == primary && rSecondary < secondary)) {
+				pos, ok, rotated = rPos, true, true
+			}
+		}
+		if !ok {
+			result.Unfit = append(result.Unfit, i)
+			continue
+		}
+		if rotated {
+			w, h = h, w
+		}
+		local := NewAABBAt(pos, w, h)
+		place(local)
+		result.Placed = append(result.Placed, Packed[T]{
+			Index:   i,
+			Box:     NewAABBAt(container.TopLeft.Add(pos), w, h),
+			Rotated: rotated,
+		})
+	}
+	slices.SortFunc(result.Placed, func(a, b Packed[T]) int { return a.Index - b.Index })
+	slices.Sort(result.Unfit)
+	return result
+}
+
+func containerSize[T Numeric](container AABB[T]) Vec[T] {
+	return container.BottomRight.Sub(container.TopLeft)
+}
+
+func compareDesc[T Numeric](a, b T) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return 0
+}
+
+// -----------------------------------------------------------------------------
+
+// maxRectsFind scores the free rectangles in container-local coordinates by the
+// shorter, then the longer leftover side.
+func maxRectsFind[T Numeric](free []AABB[T], w, h T) (Vec[T], T, T, bool) {
+	var best Vec[T]
+	var bestShort, bestLong T
+	found := false
+	for _, f := range free {
+		fw, fh := f.BottomRight.X-f.TopLeft.X, f.BottomRight.Y-f.TopLeft.Y
+		if w > fw || h > fh {
+			continue
+		}
+		short, long := min(fw-w, fh-h), max(fw-w, fh-h)
+		if !found || short < bestShort || (short == bestShort && long < bestLong) {
+			best, bestShort, bestLong, found = f.TopLeft, short, long, true
+		}
+	}
+	return best, bestShort, bestLong, found
+}
+
+// maxRectsSplit cuts placed out of every free rectangle it overlaps, keeping the
+// up to four maximal strips around it, then drops rectangles contained in others.
+func maxRectsSplit[T Numeric](free []AABB[T], placed AABB[T]) []AABB[T] {
+	next := make([]AABB[T], 0, len(free)+4)
+	for _, f := range free {
+		if _, ok := PenetrationAABB(f, placed); !ok {
+			next = append(next, f)
+			continue
+		}
+		if placed.TopLeft.X > f.TopLeft.X {
+			next = append(next, NewAABB(f.TopLeft, NewVec(placed.TopLeft.X, f.BottomRight.Y)))
+		}
+		if placed.BottomRight.X < f.BottomRight.X {
+			next = append(next, NewAABB(NewVec(placed.BottomRight.X, f.TopLeft.Y), f.BottomRight))
+		}
+		if placed.TopLeft.Y > f.TopLeft.Y {
+			next = append(next, NewAABB(f.TopLeft, NewVec(f.BottomRight.X, placed.TopLeft.Y)))
+		}
+		if placed.BottomRight.Y < f.BottomRight.Y {
+			next = append(next, NewAABB(NewVec(f.TopLeft.X, placed.BottomRight.Y), f.BottomRight))
+		}
+	}
+
+	// usuwamy prostokąty zawarte w innych; z identycznych zostaje pierwszy
+	pruned := next[:0]
+	for i, f := range next {
+		redundant := false
+		for j, g := range next {
+			if i != j && g.Contains(f) && (!f.Equals(g) || j < i) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			pruned = append(pruned, f)
+		}
+	}
+	return pruned
+}
+
+// -----------------------------------------------------------------------------
+
+// skylineSegment is a horizontal piece of the skyline: the packed area reaches
+// down to y over [x, x+width).
+type skylineSegment[T Numeric] struct {
+	x, y, width T
+}
+
+// skylineFind tries every segment as the left edge of the size and scores the
+// position by its bottom edge, then by the segment's x.
+func skylineFind[T Numeric](line []skylineSegment[T], size Vec[T], w, h T) (Vec[T], T, T, bool) {
+	var best Vec[T]
+	var bestBottom T
+	found := false
+	for i, s := range line {
+		if w > size.X-s.x {
+			break
+		}
+		y := s.y
+		for _, next := range line[i+1:] {
+			if next.x >= s.x+w {
+				break
+			}
+			y = max(y, next.y)
+		}
+		if h > size.Y-y {
+			continue
+		}
+		if !found || y+h < bestBottom {
+			best, bestBottom, found = NewVec(s.x, y), y+h, true
+		}
+	}
+	return best, bestBottom, best.X, found
+}
+
+// skylineInsert raises the skyline under placed to its bottom edge and merges
+// neighbouring segments of equal height.
+func skylineInsert[T Numeric](line []skylineSegment[T], placed AABB[T]) []skylineSegment[T] {
+	x0, x1 := placed.TopLeft.X, placed.BottomRight.X
+	if x0 == x1 {
+		return line
+	}
+	next := make([]skylineSegment[T], 0, len(line)+1)
+	inserted := false
+	for _, s := range line {
+		end := s.x + s.width
+		switch {
+		case end <= x0:
+			next = append(next, s)
+		case s.x >= x1:
+			if !inserted {
+				next = append(next, skylineSegment[T]{x0, placed.BottomRight.Y, x1 - x0})
+				inserted = true
+			}
+			next = append(next, s)
+		default:
+			if !inserted {
+				next = append(next, skylineSegment[T]{x0, placed.BottomRight.Y, x1 - x0})
+				inserted = true
+			}
+			if end > x1 {
+				next = append(next, skylineSegment[T]{x1, s.y, end - x1})
+			}
+		}
+	}
+
+	merged := next[:1]
+	for _, s := range next[1:] {
+		last := &merged[len(merged)-1]
+		if last.y == s.y {
+			last.width += s.width
+		} else {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
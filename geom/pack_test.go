@@ -0,0 +1,111 @@
+package geom
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPack(t *testing.T) {
+	runPackTest[int](t, "int")
+	runPackTest[uint32](t, "uint32")
+	runPackTest[float64](t, "float64")
+}
+
+func runPackTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		packers := []struct {
+			name string
+			pack func(AABB[T], []Vec[T], bool) PackResult[T]
+		}{
+			{name: "MaxRects", pack: PackMaxRects[T]},
+			{name: "Skyline", pack: PackSkyline[T]},
+		}
+		// kontener przesunięty od początku układu, żeby sprawdzić przeliczanie pozycji
+		container := NewAABBAt(v(3, 5), T(10), T(10))
+
+		testCases := []struct {
+			name          string
+			container     AABB[T]
+			sizes         []Vec[T]
+			allowRotation bool
+			unfit         []int
+		}{
+			{
+				name:      "perfectFit",
+				container: container,
+				sizes:     []Vec[T]{v(6, 4), v(4, 4), v(10, 6)},
+			},
+			{
+				name:      "fourQuadrantsAndOneTooMany",
+				container: container,
+				sizes:     []Vec[T]{v(5, 5), v(5, 5), v(5, 5), v(5, 5), v(5, 5)},
+				unfit:     []int{4},
+			},
+			{
+				name:      "tallWithoutRotation",
+				container: NewAABBAt(v(0, 0), T(10), T(4)),
+				sizes:     []Vec[T]{v(4, 10), v(3, 3)},
+				unfit:     []int{0},
+			},
+			{
+				name:          "tallWithRotation",
+				container:     NewAABBAt(v(0, 0), T(10), T(4)),
+				sizes:         []Vec[T]{v(4, 10), v(3, 3)},
+				allowRotation: true,
+				unfit:         []int{1},
+			},
+			{
+				name:      "manySmall",
+				container: container,
+				sizes: []Vec[T]{
+					v(3, 2), v(2, 3), v(4, 1), v(1, 4), v(2, 2), v(5, 3),
+					v(3, 5), v(1, 1), v(6, 2), v(2, 6), v(3, 3), v(1, 2),
+				},
+				allowRotation: true,
+			},
+		}
+		for _, p := range packers {
+			for _, tc := range testCases {
+				t.Run(p.name+"/"+tc.name, func(t *testing.T) {
+					result := p.pack(tc.container, tc.sizes, tc.allowRotation)
+					if !slices.Equal(result.Unfit, tc.unfit) {
+						t.Errorf("expected unfit %v, got %v", tc.unfit, result.Unfit)
+					}
+					expectPacking(t, tc.container, tc.sizes, tc.allowRotation, result)
+				})
+			}
+		}
+	})
+}
+
+// expectPacking checks that every size is either placed or unfit and that the
+// placed boxes keep their size, stay in the container and do not overlap.
+func expectPacking[T Numeric](t *testing.T, container AABB[T], sizes []Vec[T], allowRotation bool, result PackResult[T]) {
+	t.Helper()
+	if len(result.Placed)+len(result.Unfit) != len(sizes) {
+		t.Fatalf("expected %d sizes accounted for, got %d placed and %d unfit",
+			len(sizes), len(result.Placed), len(result.Unfit))
+	}
+	for i, p := range result.Placed {
+		size := p.Box.BottomRight.Sub(p.Box.TopLeft)
+		want := sizes[p.Index]
+		if p.Rotated {
+			if !allowRotation {
+				t.Errorf("size %d rotated although rotation is disabled", p.Index)
+			}
+			want = NewVec(want.Y, want.X)
+		}
+		if size != want {
+			t.Errorf("size %d: expected %v, got box %v", p.Index, want, p.Box)
+		}
+		if !container.Contains(p.Box) {
+			t.Errorf("size %d: box %v lies outside %v", p.Index, p.Box, container)
+		}
+		for _, other := range result.Placed[i+1:] {
+			if _, ok := PenetrationAABB(p.Box, other.Box); ok {
+				t.Errorf("boxes %v and %v overlap", p.Box, other.Box)
+			}
+		}
+	}
+}
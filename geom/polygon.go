@@ -0,0 +1,305 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Polygon is a closed polygon given by its vertices in order; the edge from the
+// last vertex back to the first is implied. Area, centroid, winding and point
+// queries work for any simple polygon, while the separating-axis intersection
+// tests require convex polygons.
+//
+// Signed area is positive when the vertices run counter-clockwise in a Y-up
+// frame, which is clockwise on screen where Y grows downwards.
+type Polygon[T Numeric] struct {
+	Vertices []Vec[T] `json:"vertices"`
+}
+
+// NewPolygon constructs a polygon from the given vertices.
+func NewPolygon[T Numeric](vertices ...Vec[T]) Polygon[T] {
+	return Polygon[T]{Vertices: vertices}
+}
+
+// NewPolygonFromAABB returns the four corners of box as a polygon with positive winding.
+func NewPolygonFromAABB[T Numeric](box AABB[T]) Polygon[T] {
+	return NewPolygon(
+		box.TopLeft,
+		NewVec(box.BottomRight.X, box.TopLeft.Y),
+		box.BottomRight,
+		NewVec(box.TopLeft.X, box.BottomRight.Y),
+	)
+}
+
+// String formats the polygon as "<(x,y) (x,y) ...>".
+func (p Polygon[T]) String() string {
+	parts := make([]string, len(p.Vertices))
+	for i, v := range p.Vertices {
+		parts[i] = v.String()
+	}
+	return fmt.Sprintf("<%s>", strings.Join(parts, " "))
+}
+
+// SignedArea returns the shoelace area of the polygon; its sign encodes the winding.
+func (p Polygon[T]) SignedArea() float64 {
+	n := len(p.Vertices)
+	if n < 3 {
+		return 0
+	}
+	var sum float64
+	prev := signedFloat64Vec(p.Vertices[n-1])
+	for _, v := range p.Vertices {
+		cur := signedFloat64Vec(v)
+		sum += crossFloat64(prev, cur)
+		prev = cur
+	}
+	return sum / 2
+}
+
+// Area returns the absolute area of the polygon.
+func (p Polygon[T]) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+// Centroid returns the area-weighted center of the polygon, rounded for integer
+// types. Degenerate polygons fall back to the average of their vertices.
+func (p Polygon[T]) Centroid() Vec[T] {
+	n := len(p.Vertices)
+	if n == 0 {
+		return Vec[T]{}
+	}
+	area := p.SignedArea()
+	if area == 0 {
+		var sum Vec[float64]
+		for _, v := range p.Vertices {
+			sum = sum.Add(signedFloat64Vec(v))
+		}
+		return roundToVec[T](Vec[float64]{sum.X / float64(n), sum.Y / float64(n)})
+	}
+
+	// przesunięcie do pierwszego wierzchołka ogranicza utratę precyzji dla dużych współrzędnych
+	origin := signedFloat64Vec(p.Vertices[0])
+	var cx, cy float64
+	prev := signedFloat64Vec(p.Vertices[n-1]).Sub(origin)
+	for _, v := range p.Vertices {
+		cur := signedFloat64Vec(v).Sub(origin)
+		cross := crossFloat64(prev, cur)
+		cx += (prev.X + cur.X) * cross
+		cy += (prev.Y + cur.Y) * cross
+		prev = cur
+	}
+	k := 1 / (6 * area)
+	return roundToVec[T](Vec[float64]{origin.X + cx*k, origin.Y + cy*k})
+}
+
+// BoundingAABB returns the smallest AABB containing all vertices.
+func (p Polygon[T]) BoundingAABB() AABB[T] {
+	return boundingAABBOf(p.Vertices...)
+}
+
+// IsConvex reports whether every turn along the boundary goes the same way and
+// the boundary winds around only once, which rules out stars like the pentagram.
+// Collinear vertices are allowed; polygons with fewer than three vertices are not convex.
+func (p Polygon[T]) IsConvex() bool {
+	n := len(p.Vertices)
+	if n < 3 {
+		return false
+	}
+	turn := 0
+	for i := range n {
+		o := Orient2D(p.Vertices[i], p.Vertices[(i+1)%n], p.Vertices[(i+2)%n])
+		if o != 0 && turn != 0 && o != turn {
+			return false
+		}
+		if o != 0 {
+			turn = o
+		}
+	}
+	// jeden obrót zmienia znak dx i dy najwyżej dwa razy, pentagram – cztery
+	return turn != 0 &&
+		directionFlips(p.Vertices, func(v Vec[T]) T { return v.X }) <= 2 &&
+		directionFlips(p.Vertices, func(v Vec[T]) T { return v.Y }) <= 2
+}
+
+// directionFlips counts how many times the sign of one edge direction
+// component changes around the closed boundary, skipping edges where it is zero.
+func directionFlips[T Numeric](vertices []Vec[T], coord func(Vec[T]) T) int {
+	flips, first, last := 0, 0, 0
+	for i, v := range vertices {
+		next := coord(vertices[(i+1)%len(vertices)])
+		s := 0
+		switch {
+		case signedLess(coord(v), next):
+			s = 1
+		case signedLess(next, coord(v)):
+			s = -1
+		default:
+			continue
+		}
+		if first == 0 {
+			first = s
+		} else if s != last {
+			flips++
+		}
+		last = s
+	}
+	if last != first {
+		flips++
+	}
+	return flips
+}
+
+// WithPositiveWinding returns the polygon with vertices ordered so that
+// SignedArea is non-negative. The receiver is never modified.
+func (p Polygon[T]) WithPositiveWinding() Polygon[T] {
+	vertices := slices.Clone(p.Vertices)
+	if p.SignedArea() < 0 {
+		slices.Reverse(vertices)
+	}
+	return Polygon[T]{Vertices: vertices}
+}
+
+// ContainsVec reports whether v lies strictly inside the polygon.
+func (p Polygon[T]) ContainsVec(v Vec[T]) bool {
+	inside, onEdge := p.locate(v)
+	return inside && !onEdge
+}
+
+// IntersectsVec reports whether v lies inside the polygon or on its boundary.
+func (p Polygon[T]) IntersectsVec(v Vec[T]) bool {
+	inside, onEdge := p.locate(v)
+	return inside || onEdge
+}
+
+// Intersects reports whether two convex polygons overlap or touch, using the
+// separating axis theorem.
+func (p Polygon[T]) Intersects(other Polygon[T]) bool {
+	a := polygonFloat64(p.Vertices)
+	b := polygonFloat64(other.Vertices)
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	return convexOverlap(a, b)
+}
+
+// IntersectsAABB reports whether the convex polygon overlaps or touches box.
+func (p Polygon[T]) IntersectsAABB(box AABB[T]) bool {
+	return p.Intersects(NewPolygonFromAABB(box))
+}
+
+// locate runs an even-odd crossing test and separately detects points lying on an edge.
+func (p Polygon[T]) locate(v Vec[T]) (inside, onEdge bool) {
+	n := len(p.Vertices)
+	if n == 0 {
+		return false, false
+	}
+	pt := signedFloat64Vec(v)
+	prevVertex := p.Vertices[n-1]
+	prev := signedFloat64Vec(prevVertex)
+	for _, vertex := range p.Vertices {
+		cur := signedFloat64Vec(vertex)
+		if onSegment(prevVertex, vertex, v) {
+			return false, true
+		}
+		if (cur.Y > pt.Y) != (prev.Y > pt.Y) {
+			x := cur.X + (pt.Y-cur.Y)*(prev.X-cur.X)/(prev.Y-cur.Y)
+			if pt.X < x {
+				inside = !inside
+			}
+		}
+		prev, prevVertex = cur, vertex
+	}
+	return inside, false
+}
+
+func polygonFloat64[T Numeric](vertices []Vec[T]) []Vec[float64] {
+	out := make([]Vec[float64], len(vertices))
+	for i, v := range vertices {
+		out[i] = signedFloat64Vec(v)
+	}
+	return out
+}
+
+// convexOverlap reports whether the convex point sets a and b overlap or touch.
+// Edge normals are enough for proper polygons, but a flat set (a point or
+// collinear vertices) has no normal along its own direction, so its edge
+// directions and the line between the centres are tested as well.
+func convexOverlap(a, b []Vec[float64]) bool {
+	if hasSeparatingAxis(a, b, a) || hasSeparatingAxis(a, b, b) {
+		return false
+	}
+	flatA, flatB := isFlat(a), isFlat(b)
+	if !flatA && !flatB {
+		return true
+	}
+	axes := []Vec[float64]{meanFloat64(b).Sub(meanFloat64(a))}
+	if flatA {
+		axes = appendEdges(axes, a)
+	}
+	if flatB {
+		axes = appendEdges(axes, b)
+	}
+	for _, axis := range axes {
+		if separatesOn(a, b, axis) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasSeparatingAxis tests the edge normals of edgesOf as candidate axes for a and b.
+func hasSeparatingAxis(a, b, edgesOf []Vec[float64]) bool {
+	n := len(edgesOf)
+	for i := range n {
+		edge := edgesOf[(i+1)%n].Sub(edgesOf[i])
+		if separatesOn(a, b, Vec[float64]{-edge.Y, edge.X}) {
+			return true
+		}
+	}
+	return false
+}
+
+// separatesOn reports whether the projections of a and b onto axis are
+// disjoint; a zero axis separates nothing.
+func separatesOn(a, b []Vec[float64], axis Vec[float64]) bool {
+	if axis.X == 0 && axis.Y == 0 {
+		return false
+	}
+	minA, maxA := projectOntoAxis(a, axis)
+	minB, maxB := projectOntoAxis(b, axis)
+	return maxA < minB || maxB < minA
+}
+
+// isFlat reports whether all points lie on one line.
+func isFlat(points []Vec[float64]) bool {
+	var dir Vec[float64]
+	for _, p := range points[1:] {
+		d := p.Sub(points[0])
+		if dir == (Vec[float64]{}) {
+			dir = d
+		} else if crossFloat64(dir, d) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func appendEdges(edges, points []Vec[float64]) []Vec[float64] {
+	for i := range points {
+		edges = append(edges, points[(i+1)%len(points)].Sub(points[i]))
+	}
+	return edges
+}
+
+func projectOntoAxis(points []Vec[float64], axis Vec[float64]) (lo, hi float64) {
+	lo = dotFloat64(points[0], axis)
+	hi = lo
+	for _, p := range points[1:] {
+		d := dotFloat64(p, axis)
+		lo = min(lo, d)
+		hi = max(hi, d)
+	}
+	return lo, hi
+}
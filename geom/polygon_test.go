@@ -0,0 +1,220 @@
+package geom
+
+import "testing"
+
+func TestPolygon_AreaAndCentroid(t *testing.T) {
+	runPolygonAreaTest[int](t, "int")
+	runPolygonAreaTest[uint32](t, "uint32")
+	runPolygonAreaTest[float64](t, "float64")
+}
+
+func runPolygonAreaTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		square := NewPolygon(
+			NewVec(T(2), T(2)), NewVec(T(6), T(2)), NewVec(T(6), T(6)), NewVec(T(2), T(6)),
+		)
+		if got := square.SignedArea(); got != 16 {
+			t.Errorf("signed area = %v, want 16", got)
+		}
+		if got := square.Centroid(); got != NewVec(T(4), T(4)) {
+			t.Errorf("centroid = %v, want (4,4)", got)
+		}
+
+		reversed := NewPolygon(
+			NewVec(T(2), T(6)), NewVec(T(6), T(6)), NewVec(T(6), T(2)), NewVec(T(2), T(2)),
+		)
+		if got := reversed.SignedArea(); got != -16 {
+			t.Errorf("reversed signed area = %v, want -16", got)
+		}
+		if got := reversed.Area(); got != 16 {
+			t.Errorf("reversed area = %v, want 16", got)
+		}
+		normalized := reversed.WithPositiveWinding()
+		if got := normalized.SignedArea(); got != 16 {
+			t.Errorf("normalized signed area = %v, want 16", got)
+		}
+		if reversed.Vertices[0] != NewVec(T(2), T(6)) {
+			t.Errorf("WithPositiveWinding must not modify the receiver")
+		}
+
+		triangle := NewPolygon(NewVec(T(0), T(0)), NewVec(T(6), T(0)), NewVec(T(0), T(6)))
+		if got := triangle.Centroid(); got != NewVec(T(2), T(2)) {
+			t.Errorf("triangle centroid = %v, want (2,2)", got)
+		}
+
+		want := NewAABB(NewVec(T(0), T(0)), NewVec(T(6), T(6)))
+		if got := triangle.BoundingAABB(); got != want {
+			t.Errorf("bounding box = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestPolygon_BoundingAABBBelowZero(t *testing.T) {
+	// 0xFFFF_FFF8 to -8 w odczycie ze znakiem, więc pudełko zaczyna się przed zerem
+	below := uint32(0xFFFF_FFF8)
+	triangle := NewPolygon(NewVec[uint32](below, 2), NewVec[uint32](6, 0), NewVec[uint32](4, 10))
+	want := NewAABB(NewVec[uint32](below, 0), NewVec[uint32](6, 10))
+	if got := triangle.BoundingAABB(); got != want {
+		t.Errorf("bounding box = %v, want %v", got, want)
+	}
+	if got := BoundsOf(triangle.Vertices); got != want {
+		t.Errorf("BoundsOf = %v, want %v", got, want)
+	}
+}
+
+func TestPolygon_IsConvex(t *testing.T) {
+	runPolygonIsConvexTest[int](t, "int")
+	runPolygonIsConvexTest[uint32](t, "uint32")
+	runPolygonIsConvexTest[float64](t, "float64")
+}
+
+func runPolygonIsConvexTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		convex := NewPolygon(NewVec(T(0), T(0)), NewVec(T(4), T(0)), NewVec(T(4), T(4)), NewVec(T(0), T(4)))
+		arrow := NewPolygon(NewVec(T(0), T(0)), NewVec(T(4), T(2)), NewVec(T(0), T(4)), NewVec(T(1), T(2)))
+		if !convex.IsConvex() {
+			t.Errorf("%v should be convex", convex)
+		}
+		if arrow.IsConvex() {
+			t.Errorf("%v should not be convex", arrow)
+		}
+		// każdy zakręt pentagramu idzie w tę samą stronę, ale brzeg okrąża środek dwa razy
+		star := NewPolygon(
+			NewVec(T(5), T(10)), NewVec(T(8), T(1)), NewVec(T(0), T(7)), NewVec(T(10), T(7)), NewVec(T(2), T(1)),
+		)
+		if star.IsConvex() {
+			t.Errorf("%v should not be convex", star)
+		}
+		collinear := NewPolygon(NewVec(T(0), T(0)), NewVec(T(2), T(0)), NewVec(T(4), T(0)), NewVec(T(4), T(4)), NewVec(T(0), T(4)))
+		if !collinear.IsConvex() {
+			t.Errorf("%v should be convex", collinear)
+		}
+	})
+}
+
+func TestPolygon_PointQueries(t *testing.T) {
+	runPolygonPointQueriesTest[int](t, "int")
+	runPolygonPointQueriesTest[uint32](t, "uint32")
+	runPolygonPointQueriesTest[float64](t, "float64")
+}
+
+func runPolygonPointQueriesTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		// wklęsły kształt litery L
+		poly := NewPolygon(
+			NewVec(T(0), T(0)), NewVec(T(6), T(0)), NewVec(T(6), T(2)),
+			NewVec(T(2), T(2)), NewVec(T(2), T(6)), NewVec(T(0), T(6)),
+		)
+
+		testCases := []struct {
+			name       string
+			point      Vec[T]
+			contains   bool
+			intersects bool
+		}{
+			{name: "insideFoot", point: NewVec(T(4), T(1)), contains: true, intersects: true},
+			{name: "insideStem", point: NewVec(T(1), T(5)), contains: true, intersects: true},
+			{name: "inNotch", point: NewVec(T(4), T(4)), contains: false, intersects: false},
+			{name: "onEdge", point: NewVec(T(3), T(2)), contains: false, intersects: true},
+			{name: "onVertex", point: NewVec(T(6), T(0)), contains: false, intersects: true},
+			{name: "outside", point: NewVec(T(8), T(1)), contains: false, intersects: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := poly.ContainsVec(tc.point); got != tc.contains {
+					t.Errorf("contains %v = %v, want %v", tc.point, got, tc.contains)
+				}
+				if got := poly.IntersectsVec(tc.point); got != tc.intersects {
+					t.Errorf("intersects %v = %v, want %v", tc.point, got, tc.intersects)
+				}
+			})
+		}
+	})
+}
+
+func TestPolygon_Intersects(t *testing.T) {
+	runPolygonIntersectsTest[int](t, "int")
+	runPolygonIntersectsTest[uint32](t, "uint32")
+	runPolygonIntersectsTest[float64](t, "float64")
+}
+
+func runPolygonIntersectsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		diamond := NewPolygon(NewVec(T(4), T(0)), NewVec(T(8), T(4)), NewVec(T(4), T(8)), NewVec(T(0), T(4)))
+		mkTri := func(x, y T) Polygon[T] {
+			return NewPolygon(NewVec(x, y), NewVec(x+T(2), y), NewVec(x, y+T(2)))
+		}
+
+		testCases := []struct {
+			name  string
+			other Polygon[T]
+			want  bool
+		}{
+			{name: "inside", other: mkTri(T(3), T(3)), want: true},
+			{name: "overlapEdge", other: mkTri(T(6), T(5)), want: true},
+			{name: "touchVertex", other: mkTri(T(8), T(4)), want: true},
+			{name: "nearCornerGap", other: mkTri(T(0), T(0)), want: false},
+			{name: "farAway", other: mkTri(T(20), T(20)), want: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := diamond.Intersects(tc.other); got != tc.want {
+					t.Errorf("%v intersects %v = %v, want %v", diamond, tc.other, got, tc.want)
+				}
+				if got := tc.other.Intersects(diamond); got != tc.want {
+					t.Errorf("%v intersects %v = %v, want %v", tc.other, diamond, got, tc.want)
+				}
+			})
+		}
+
+		if !diamond.IntersectsAABB(NewAABBAt(NewVec(T(6), T(6)), T(4), T(4))) {
+			t.Errorf("diamond should touch box at (6,6)")
+		}
+		if diamond.IntersectsAABB(NewAABBAt(NewVec(T(7), T(7)), T(4), T(4))) {
+			t.Errorf("diamond should not reach box at (7,7)")
+		}
+	})
+}
+
+func TestPolygon_IntersectsDegenerate(t *testing.T) {
+	runPolygonIntersectsDegenerateTest[int](t, "int")
+	runPolygonIntersectsDegenerateTest[uint32](t, "uint32")
+	runPolygonIntersectsDegenerateTest[float64](t, "float64")
+}
+
+func runPolygonIntersectsDegenerateTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		square := NewPolygon(v(0, 0), v(4, 0), v(4, 4), v(0, 4))
+
+		testCases := []struct {
+			name string
+			a, b Polygon[T]
+			want bool
+		}{
+			{name: "distinctPoints", a: NewPolygon(v(0, 0)), b: NewPolygon(v(100, 100)), want: false},
+			{name: "samePoint", a: NewPolygon(v(3, 3)), b: NewPolygon(v(3, 3)), want: true},
+			{name: "collinearSegmentsApart", a: ConvexHull([]Vec[T]{v(0, 0), v(1, 0), v(2, 0)}),
+				b: ConvexHull([]Vec[T]{v(10, 0), v(20, 0)}), want: false},
+			{name: "collinearSegmentsOverlap", a: NewPolygon(v(0, 0), v(5, 0)), b: NewPolygon(v(3, 0), v(8, 0)), want: true},
+			{name: "crossingSegments", a: NewPolygon(v(0, 0), v(4, 4)), b: NewPolygon(v(0, 4), v(4, 0)), want: true},
+			{name: "pointOnSegment", a: NewPolygon(v(0, 0), v(4, 4)), b: NewPolygon(v(2, 2)), want: true},
+			{name: "pointBesideSegmentLine", a: NewPolygon(v(0, 0), v(4, 4)), b: NewPolygon(v(6, 6)), want: false},
+			{name: "segmentInsideSquare", a: square, b: NewPolygon(v(1, 1), v(3, 1)), want: true},
+			{name: "segmentPastSquare", a: square, b: NewPolygon(v(6, 2), v(9, 2)), want: false},
+			{name: "pointPastSquare", a: square, b: NewPolygon(v(5, 2)), want: false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := tc.a.Intersects(tc.b); got != tc.want {
+					t.Errorf("%v intersects %v = %v, want %v", tc.a, tc.b, got, tc.want)
+				}
+				if got := tc.b.Intersects(tc.a); got != tc.want {
+					t.Errorf("%v intersects %v = %v, want %v", tc.b, tc.a, got, tc.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package geom
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Orient2D reports on which side of the directed line a→b the point c lies:
+// +1 when a, b, c turn counter-clockwise in a Y-up frame (positive signed area,
+// see Polygon.SignedArea), -1 when they turn clockwise and 0 when collinear.
+//
+// The sign is always exact. Integer components are evaluated with 128-bit
+// intermediates (unsigned read as signed, like UnsignedIntVectorMath); floating
+// components go through a Shewchuk-style error-bound filter and fall back to
+// exact rational arithmetic only when the fast estimate is not trustworthy.
+func Orient2D[T Numeric](a, b, c Vec[T]) int {
+	if isFloating[T]() {
+		return orient2DFloat64(toFloat64Vec(a), toFloat64Vec(b), toFloat64Vec(c))
+	}
+	return orient2DInt64(signedInt64Vec(a), signedInt64Vec(b), signedInt64Vec(c))
+}
+
+// InCircle reports where d lies relative to the circle through a, b and c:
+// +1 inside, -1 outside and 0 on the circle, assuming Orient2D(a, b, c) > 0.
+// For clockwise a, b, c the sign is reversed. Exactness follows Orient2D.
+func InCircle[T Numeric](a, b, c, d Vec[T]) int {
+	if isFloating[T]() {
+		return inCircleFloat64(toFloat64Vec(a), toFloat64Vec(b), toFloat64Vec(c), toFloat64Vec(d))
+	}
+	return inCircleInt64(signedInt64Vec(a), signedInt64Vec(b), signedInt64Vec(c), signedInt64Vec(d))
+}
+
+// -----------------------------------------------------------------------------
+
+const (
+	// machineEpsilon is half an ulp of 1.0 for float64 (Shewchuk's epsilon).
+	machineEpsilon = 1.0 / (1 << 53)
+	ccwErrBoundA   = (3 + 16*machineEpsilon) * machineEpsilon
+	iccErrBoundA   = (10 + 96*machineEpsilon) * machineEpsilon
+)
+
+func orient2DFloat64(a, b, c Vec[float64]) int {
+	detLeft := (a.X - c.X) * (b.Y - c.Y)
+	detRight := (a.Y - c.Y) * (b.X - c.X)
+	det := detLeft - detRight
+
+	detSum := math.Abs(detLeft) + math.Abs(detRight)
+	if math.Abs(det) >= ccwErrBoundA*detSum || !isFiniteFloat64(det) {
+		return sign(det)
+	}
+
+	// filtr nie rozstrzygnął – liczymy dokładnie
+	ax, ay := exactRat(a.X), exactRat(a.Y)
+	bx, by := exactRat(b.X), exactRat(b.Y)
+	cx, cy := exactRat(c.X), exactRat(c.Y)
+	left := new(big.Rat).Mul(ratSub(ax, cx), ratSub(by, cy))
+	right := new(big.Rat).Mul(ratSub(ay, cy), ratSub(bx, cx))
+	return left.Sub(left, right).Sign()
+}
+
+func inCircleFloat64(a, b, c, d Vec[float64]) int {
+	adx, ady := a.X-d.X, a.Y-d.Y
+	bdx, bdy := b.X-d.X, b.Y-d.Y
+	cdx, cdy := c.X-d.X, c.Y-d.Y
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	aLift := adx*adx + ady*ady
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	bLift := bdx*bdx + bdy*bdy
+	adxbdy, bdxady := adx*bdy, bdx*ady
+	cLift := cdx*cdx + cdy*cdy
+
+	det := aLift*(bdxcdy-cdxbdy) + bLift*(cdxady-adxcdy) + cLift*(adxbdy-bdxady)
+	permanent := (math.Abs(bdxcdy)+math.Abs(cdxbdy))*aLift +
+		(math.Abs(cdxady)+math.Abs(adxcdy))*bLift +
+		(math.Abs(adxbdy)+math.Abs(bdxady))*cLift
+	if math.Abs(det) > iccErrBoundA*permanent || !isFiniteFloat64(det) {
+		return sign(det)
+	}
+
+	return inCircleRat(
+		[2]*big.Rat{ratSub(exactRat(a.X), exactRat(d.X)), ratSub(exactRat(a.Y), exactRat(d.Y))},
+		[2]*big.Rat{ratSub(exactRat(b.X), exactRat(d.X)), ratSub(exactRat(b.Y), exactRat(d.Y))},
+		[2]*big.Rat{ratSub(exactRat(c.X), exactRat(d.X)), ratSub(exactRat(c.Y), exactRat(d.Y))},
+	)
+}
+
+// inCircleRat evaluates the lifted 3x3 determinant over points already translated by -d.
+func inCircleRat(a, b, c [2]*big.Rat) int {
+	lift := func(p [2]*big.Rat) *big.Rat {
+		x := new(big.Rat).Mul(p[0], p[0])
+		return x.Add(x, new(big.Rat).Mul(p[1], p[1]))
+	}
+	cross := func(p, q [2]*big.Rat) *big.Rat {
+		x := new(big.Rat).Mul(p[0], q[1])
+		return x.Sub(x, new(big.Rat).Mul(q[0], p[1]))
+	}
+	det := new(big.Rat).Mul(lift(a), cross(b, c))
+	det.Add(det, new(big.Rat).Mul(lift(b), cross(c, a)))
+	det.Add(det, new(big.Rat).Mul(lift(c), cross(a, b)))
+	return det.Sign()
+}
+
+func exactRat(f float64) *big.Rat { return new(big.Rat).SetFloat64(f) }
+
+func ratSub(x, y *big.Rat) *big.Rat { return new(big.Rat).Sub(x, y) }
+
+func isFiniteFloat64(f float64) bool { return !math.IsNaN(f) && !math.IsInf(f, 0) }
+
+func sign[T SignedInt | Floating](x T) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// -----------------------------------------------------------------------------
+
+func orient2DInt64(a, b, c Vec[int64]) int {
+	abx, okX := sub64(b.X, a.X)
+	aby, okY := sub64(b.Y, a.Y)
+	acx, okCX := sub64(c.X, a.X)
+	acy, okCY := sub64(c.Y, a.Y)
+	if !okX || !okY || !okCX || !okCY {
+		// różnice nie mieszczą się w int64 – wolna, ale dokładna ścieżka
+		return orient2DBig(a, b, c)
+	}
+	return mul64(abx, acy).sub(mul64(aby, acx)).sign()
+}
+
+func inCircleInt64(a, b, c, d Vec[int64]) int {
+	const limit = 1 << 30
+	var diffs [6]int64
+	for i, pair := range [6][2]int64{
+		{a.X, d.X}, {a.Y, d.Y}, {b.X, d.X}, {b.Y, d.Y}, {c.X, d.X}, {c.Y, d.Y},
+	} {
+		diff, ok := sub64(pair[0], pair[1])
+		if !ok || diff >= limit || diff <= -limit {
+			return inCircleBig(a, b, c, d)
+		}
+		diffs[i] = diff
+	}
+	adx, ady, bdx, bdy, cdx, cdy := diffs[0], diffs[1], diffs[2], diffs[3], diffs[4], diffs[5]
+
+	// przy |różnicach| < 2^30 wzniesienia i iloczyny wektorowe mieszczą się w int64,
+	// a suma trzech iloczynów w int128
+	aLift := adx*adx + ady*ady
+	bLift := bdx*bdx + bdy*bdy
+	cLift := cdx*cdx + cdy*cdy
+	det := mul64(aLift, bdx*cdy-cdx*bdy).
+		add(mul64(bLift, cdx*ady-adx*cdy)).
+		add(mul64(cLift, adx*bdy-bdx*ady))
+	return det.sign()
+}
+
+func orient2DBig(a, b, c Vec[int64]) int {
+	abx := new(big.Int).Sub(big.NewInt(b.X), big.NewInt(a.X))
+	aby := new(big.Int).Sub(big.NewInt(b.Y), big.NewInt(a.Y))
+	acx := new(big.Int).Sub(big.NewInt(c.X), big.NewInt(a.X))
+	acy := new(big.Int).Sub(big.NewInt(c.Y), big.NewInt(a.Y))
+	left := new(big.Int).Mul(abx, acy)
+	return left.Sub(left, new(big.Int).Mul(aby, acx)).Sign()
+}
+
+func inCircleBig(a, b, c, d Vec[int64]) int {
+	toRat := func(p Vec[int64]) [2]*big.Rat {
+		return [2]*big.Rat{
+			ratSub(new(big.Rat).SetInt64(p.X), new(big.Rat).SetInt64(d.X)),
+			ratSub(new(big.Rat).SetInt64(p.Y), new(big.Rat).SetInt64(d.Y)),
+		}
+	}
+	return inCircleRat(toRat(a), toRat(b), toRat(c))
+}
+
+func signedInt64Vec[T Numeric](v Vec[T]) Vec[int64] {
+	return Vec[int64]{signedInt64(v.X), signedInt64(v.Y)}
+}
+
+// sub64 returns x-y and whether the result did not overflow.
+func sub64(x, y int64) (int64, bool) {
+	d := x - y
+	return d, (x >= 0) == (y >= 0) || (d >= 0) == (x >= 0)
+}
+
+// int128 is a two's complement 128-bit integer used for exact predicate products.
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// mul64 returns the exact 128-bit product of x and y.
+func mul64(x, y int64) int128 {
+	neg := (x < 0) != (y < 0)
+	hi, lo := bits.Mul64(absUint64(x), absUint64(y))
+	r := int128{hi: int64(hi), lo: lo}
+	if neg {
+		r = r.neg()
+	}
+	return r
+}
+
+func absUint64(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+	return uint64(x)
+}
+
+func (x int128) add(y int128) int128 {
+	lo, carry := bits.Add64(x.lo, y.lo, 0)
+	return int128{hi: x.hi + y.hi + int64(carry), lo: lo}
+}
+
+func (x int128) sub(y int128) int128 {
+	lo, borrow := bits.Sub64(x.lo, y.lo, 0)
+	return int128{hi: x.hi - y.hi - int64(borrow), lo: lo}
+}
+
+func (x int128) neg() int128 {
+	return int128{}.sub(x)
+}
+
+func (x int128) sign() int {
+	switch {
+	case x.hi < 0:
+		return -1
+	case x.hi > 0 || x.lo != 0:
+		return 1
+	}
+	return 0
+}
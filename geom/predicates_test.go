@@ -0,0 +1,120 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOrient2D(t *testing.T) {
+	runOrient2DTest[int](t, "int")
+	runOrient2DTest[int64](t, "int64")
+	runOrient2DTest[uint32](t, "uint32")
+	runOrient2DTest[float64](t, "float64")
+}
+
+func runOrient2DTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		a, b := NewVec(T(1), T(1)), NewVec(T(5), T(3))
+		testCases := []struct {
+			name string
+			c    Vec[T]
+			want int
+		}{
+			{name: "left", c: NewVec(T(2), T(4)), want: 1},
+			{name: "right", c: NewVec(T(4), T(1)), want: -1},
+			{name: "collinearBeyond", c: NewVec(T(9), T(5)), want: 0},
+			{name: "collinearBetween", c: NewVec(T(3), T(2)), want: 0},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := Orient2D(a, b, tc.c); got != tc.want {
+					t.Errorf("Orient2D(%v, %v, %v) = %d, want %d", a, b, tc.c, got, tc.want)
+				}
+				if got := Orient2D(b, a, tc.c); got != -tc.want {
+					t.Errorf("Orient2D(%v, %v, %v) = %d, want %d", b, a, tc.c, got, -tc.want)
+				}
+			})
+		}
+	})
+}
+
+func TestOrient2D_NearlyCollinearFloats(t *testing.T) {
+	// klasyczny przykład Kettnera i in.: punkty o krok ulp od prostej y=x,
+	// dla których naiwny iloczyn wektorowy zwraca błędne znaki
+	b := NewVec(12.0, 12.0)
+	c := NewVec(24.0, 24.0)
+	for i := range 64 {
+		for j := range 64 {
+			p := NewVec(0.5+float64(i)*math.Ldexp(1, -53), 0.5+float64(j)*math.Ldexp(1, -53))
+			if got, want := Orient2D(p, b, c), exactOrientSign(p, b, c); got != want {
+				t.Fatalf("Orient2D(%v, %v, %v) = %d, want %d", p, b, c, got, want)
+			}
+		}
+	}
+}
+
+func TestOrient2D_Int64Extremes(t *testing.T) {
+	lo, hi := int64(math.MinInt64), int64(math.MaxInt64)
+	a := NewVec(lo, lo)
+	b := NewVec(hi, hi)
+	if got := Orient2D(a, b, NewVec(int64(0), int64(-1))); got != -1 {
+		t.Errorf("expected point below diagonal to be on the right, got %d", got)
+	}
+	if got := Orient2D(a, b, NewVec(int64(1), int64(1))); got != 0 {
+		t.Errorf("expected point on diagonal to be collinear, got %d", got)
+	}
+	if got := Orient2D(NewVec(hi, hi), NewVec(hi-1, hi), NewVec(hi, hi-1)); got != 1 {
+		t.Errorf("expected counter-clockwise turn near MaxInt64, got %d", got)
+	}
+}
+
+func TestInCircle(t *testing.T) {
+	runInCircleTest[int](t, "int")
+	runInCircleTest[int64](t, "int64")
+	runInCircleTest[uint32](t, "uint32")
+	runInCircleTest[float64](t, "float64")
+}
+
+func runInCircleTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		// okrąg o środku (5,5) i promieniu 5, wierzchołki przeciwnie do ruchu wskazówek
+		a, b, c := NewVec(T(10), T(5)), NewVec(T(5), T(10)), NewVec(T(0), T(5))
+		testCases := []struct {
+			name string
+			d    Vec[T]
+			want int
+		}{
+			{name: "center", d: NewVec(T(5), T(5)), want: 1},
+			{name: "onCircle", d: NewVec(T(5), T(0)), want: 0},
+			{name: "onCirclePythagorean", d: NewVec(T(8), T(9)), want: 0},
+			{name: "outside", d: NewVec(T(10), T(10)), want: -1},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := InCircle(a, b, c, tc.d); got != tc.want {
+					t.Errorf("InCircle(%v) = %d, want %d", tc.d, got, tc.want)
+				}
+				if got := InCircle(c, b, a, tc.d); got != -tc.want {
+					t.Errorf("clockwise InCircle(%v) = %d, want %d", tc.d, got, -tc.want)
+				}
+			})
+		}
+	})
+}
+
+func TestInCircle_LargeInt64UsesExactPath(t *testing.T) {
+	const big = int64(1) << 40
+	a, b, c := NewVec(big+10, big+5), NewVec(big+5, big+10), NewVec(big, big+5)
+	if got := InCircle(a, b, c, NewVec(big+5, big)); got != 0 {
+		t.Errorf("expected cocircular point, got %d", got)
+	}
+	if got := InCircle(a, b, c, NewVec(big+5, big+1)); got != 1 {
+		t.Errorf("expected inside point, got %d", got)
+	}
+}
+
+func exactOrientSign(a, b, c Vec[float64]) int {
+	left := exactRat(0).Mul(ratSub(exactRat(a.X), exactRat(c.X)), ratSub(exactRat(b.Y), exactRat(c.Y)))
+	right := exactRat(0).Mul(ratSub(exactRat(a.Y), exactRat(c.Y)), ratSub(exactRat(b.X), exactRat(c.X)))
+	return left.Sub(left, right).Sign()
+}
@@ -0,0 +1,177 @@
+package geom
+
+import (
+	"iter"
+	"math"
+)
+
+// GridTraversal selects which cells GridCells yields for a line.
+type GridTraversal int
+
+const (
+	// GridSupercover yields every cell the line passes through, walking the
+	// grid with the Amanatides–Woo algorithm. Consecutive cells share an edge;
+	// where the line runs exactly through a grid corner, both cells beside the
+	// corner are yielded before the diagonal one, so nothing can be seen
+	// through a gap between two blocked tiles.
+	GridSupercover GridTraversal = iota
+	// GridBresenham yields the thin 8-connected Bresenham line: one cell per
+	// step along the longer axis.
+	GridBresenham
+)
+
+// GridCells yields, in order from from to to, the cells a line crosses on the
+// unit grid where cell (i, j) covers [i, i+1) x [j, j+1). Both end cells are
+// included.
+//
+// For integer types from and to are cell indices and the line runs between
+// the cell centers. For floating types and Fixed they are positions, so a ray
+// can start anywhere inside a cell; scale world coordinates by the cell size
+// first to walk a coarser grid. Cells left of or above the origin come out in
+// two's complement, the way geom reads unsigned components as signed. A NaN
+// or infinite endpoint yields no cells.
+func GridCells[T Numeric](from, to Vec[T], traversal GridTraversal) iter.Seq[Vec[uint32]] {
+	return gridCells(gridPosition(from), gridPosition(to), traversal, nil)
+}
+
+// GridCellsWrapped is GridCells on a torus of size cells per axis: the line
+// takes the short way across the seams and every cell is wrapped into
+// [0, size). A zero size component leaves that axis unwrapped; a NaN or
+// infinite one yields no cells.
+func GridCellsWrapped[T Numeric](from, to, size Vec[T], traversal GridTraversal) iter.Seq[Vec[uint32]] {
+	a, b := gridPosition(from), gridPosition(to)
+	s := signedFloat64Vec(size)
+	if s.X > 0 {
+		b.X = a.X + shortestDelta(b.X-a.X, s.X)
+	}
+	if s.Y > 0 {
+		b.Y = a.Y + shortestDelta(b.Y-a.Y, s.Y)
+	}
+	cells := [2]int64{int64(math.Ceil(s.X - eps)), int64(math.Ceil(s.Y - eps))}
+	return gridCells(a, b, traversal, func(x, y int64) (int64, int64) {
+		return wrapCell(x, cells[0]), wrapCell(y, cells[1])
+	})
+}
+
+// gridCells dispatches to the traversal and maps cells through wrap when set.
+func gridCells(a, b Vec[float64], traversal GridTraversal, wrap func(x, y int64) (int64, int64)) iter.Seq[Vec[uint32]] {
+	return func(yield func(Vec[uint32]) bool) {
+		// bez tego pętla nigdy nie dojdzie do końca odcinka
+		for _, f := range [4]float64{a.X, a.Y, b.X, b.Y} {
+			if !isFiniteFloat64(f) {
+				return
+			}
+		}
+		emit := func(x, y int64) bool {
+			if wrap != nil {
+				x, y = wrap(x, y)
+			}
+			return yield(Vec[uint32]{uint32(x), uint32(y)})
+		}
+		if traversal == GridBresenham {
+			bresenham(a, b, emit)
+		} else {
+			supercover(a, b, emit)
+		}
+	}
+}
+
+// supercover walks the cells of segment a-b with Amanatides–Woo: tMax is the
+// segment parameter at which the next vertical or horizontal grid line is
+// crossed and tDelta the parameter distance between such lines.
+func supercover(a, b Vec[float64], emit func(x, y int64) bool) {
+	x, y := int64(math.Floor(a.X)), int64(math.Floor(a.Y))
+	endX, endY := int64(math.Floor(b.X)), int64(math.Floor(b.Y))
+	stepX, tMaxX, tDeltaX := gridAxis(a.X, b.X, x)
+	stepY, tMaxY, tDeltaY := gridAxis(a.Y, b.Y, y)
+
+	if !emit(x, y) {
+		return
+	}
+	for x != endX || y != endY {
+		canX, canY := x != endX, y != endY
+		switch {
+		case canX && (!canY || tMaxX < tMaxY-eps):
+			x += stepX
+			tMaxX += tDeltaX
+		case canY && (!canX || tMaxY < tMaxX-eps):
+			y += stepY
+			tMaxY += tDeltaY
+		default:
+			// linia przechodzi dokładnie przez narożnik – oddajemy obie sąsiednie komórki
+			if !emit(x+stepX, y) || !emit(x, y+stepY) {
+				return
+			}
+			x, y = x+stepX, y+stepY
+			tMaxX, tMaxY = tMaxX+tDeltaX, tMaxY+tDeltaY
+		}
+		if !emit(x, y) {
+			return
+		}
+	}
+}
+
+// gridAxis returns the step direction, the parameter of the first grid line
+// crossing and the parameter distance between crossings along one axis.
+func gridAxis(a, b float64, cell int64) (step int64, tMax, tDelta float64) {
+	d := b - a
+	switch {
+	case d > 0:
+		return 1, (float64(cell) + 1 - a) / d, 1 / d
+	case d < 0:
+		return -1, (a - float64(cell)) / -d, 1 / -d
+	}
+	return 0, math.Inf(1), math.Inf(1)
+}
+
+// bresenham walks the thin line between the cells containing a and b.
+func bresenham(a, b Vec[float64], emit func(x, y int64) bool) {
+	x, y := int64(math.Floor(a.X)), int64(math.Floor(a.Y))
+	endX, endY := int64(math.Floor(b.X)), int64(math.Floor(b.Y))
+	dx, dy := endX-x, -(endY - y)
+	stepX, stepY := int64(1), int64(1)
+	if dx < 0 {
+		dx, stepX = -dx, -1
+	}
+	if dy > 0 {
+		dy, stepY = -dy, -1
+	}
+
+	err := dx + dy
+	for {
+		if !emit(x, y) || (x == endX && y == endY) {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x += stepX
+		}
+		if e2 <= dx {
+			err += dx
+			y += stepY
+		}
+	}
+}
+
+// gridPosition returns the point the line passes through for v: the cell
+// center for integer types and v itself otherwise.
+func gridPosition[T Numeric](v Vec[T]) Vec[float64] {
+	p := signedFloat64Vec(v)
+	if isFloating[T]() || fixedScale[T]() != 1 {
+		return p
+	}
+	return Vec[float64]{p.X + 0.5, p.Y + 0.5}
+}
+
+// shortestDelta returns d shifted by whole sizes into [-size/2, size/2].
+func shortestDelta(d, size float64) float64 {
+	return d - size*math.Round(d/size)
+}
+
+func wrapCell(i, n int64) int64 {
+	if n <= 0 {
+		return i
+	}
+	return (i%n + n) % n
+}
@@ -0,0 +1,133 @@
+package geom
+
+import (
+	"iter"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestGridCells(t *testing.T) {
+	runGridCellsTest[int](t, "int")
+	runGridCellsTest[uint32](t, "uint32")
+	runGridCellsTest[int16](t, "int16")
+}
+
+func runGridCellsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		c := func(x, y uint32) Vec[uint32] { return NewVec(x, y) }
+		minusOne := int32(-1)
+		m1 := uint32(minusOne)
+
+		testCases := []struct {
+			name      string
+			from, to  Vec[T]
+			traversal GridTraversal
+			expected  []Vec[uint32]
+		}{
+			{name: "supercoverShallow", from: v(0, 0), to: v(5, 2), traversal: GridSupercover,
+				expected: []Vec[uint32]{c(0, 0), c(1, 0), c(1, 1), c(2, 1), c(3, 1), c(4, 1), c(4, 2), c(5, 2)}},
+			{name: "supercoverDiagonalCorners", from: v(0, 0), to: v(2, 2), traversal: GridSupercover,
+				expected: []Vec[uint32]{c(0, 0), c(1, 0), c(0, 1), c(1, 1), c(2, 1), c(1, 2), c(2, 2)}},
+			{name: "supercoverBackwards", from: v(3, 0), to: v(0, 0), traversal: GridSupercover,
+				expected: []Vec[uint32]{c(3, 0), c(2, 0), c(1, 0), c(0, 0)}},
+			{name: "supercoverSingleCell", from: v(4, 4), to: v(4, 4), traversal: GridSupercover,
+				expected: []Vec[uint32]{c(4, 4)}},
+			{name: "bresenhamShallow", from: v(0, 0), to: v(5, 2), traversal: GridBresenham,
+				expected: []Vec[uint32]{c(0, 0), c(1, 0), c(2, 1), c(3, 1), c(4, 2), c(5, 2)}},
+			{name: "bresenhamDiagonal", from: v(2, 2), to: v(0, 0), traversal: GridBresenham,
+				expected: []Vec[uint32]{c(2, 2), c(1, 1), c(0, 0)}},
+			{name: "bresenhamSteep", from: v(0, 0), to: v(1, 3), traversal: GridBresenham,
+				expected: []Vec[uint32]{c(0, 0), c(0, 1), c(1, 2), c(1, 3)}},
+			{name: "bresenhamPastOrigin", from: v(1, 0), to: Vec[T]{T(minusOne), 0}, traversal: GridBresenham,
+				expected: []Vec[uint32]{c(1, 0), c(0, 0), c(m1, 0)}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got := slices.Collect(GridCells(tc.from, tc.to, tc.traversal))
+				if !slices.Equal(got, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+	})
+}
+
+func TestGridCells_Positions(t *testing.T) {
+	// pozycje zmiennoprzecinkowe: promień zaczyna się w dowolnym miejscu komórki
+	got := slices.Collect(GridCells(NewVec(0.2, 0.2), NewVec(2.7, 0.9), GridSupercover))
+	expected := []Vec[uint32]{{0, 0}, {1, 0}, {2, 0}}
+	if !slices.Equal(got, expected) {
+		t.Errorf("float64: expected %v, got %v", expected, got)
+	}
+
+	got = slices.Collect(GridCells(NewVec(FixedFromFloat64(0.5), 0), NewVec(FixedFromFloat64(2.5), FixedFromInt(1)+FixedOne/2), GridSupercover))
+	expected = []Vec[uint32]{{0, 0}, {1, 0}, {1, 1}, {2, 1}}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Fixed: expected %v, got %v", expected, got)
+	}
+}
+
+func TestGridCellsWrapped(t *testing.T) {
+	size := NewVec(uint32(10), uint32(10))
+	testCases := []struct {
+		name      string
+		from, to  Vec[uint32]
+		traversal GridTraversal
+		expected  []Vec[uint32]
+	}{
+		{name: "shortWayLeft", from: NewVec(uint32(1), uint32(5)), to: NewVec(uint32(8), uint32(5)), traversal: GridBresenham,
+			expected: []Vec[uint32]{{1, 5}, {0, 5}, {9, 5}, {8, 5}}},
+		{name: "throughCornerAcrossSeam", from: NewVec(uint32(1), uint32(5)), to: NewVec(uint32(8), uint32(6)), traversal: GridSupercover,
+			expected: []Vec[uint32]{{1, 5}, {0, 5}, {9, 5}, {0, 6}, {9, 6}, {8, 6}}},
+		{name: "acrossBothSeams", from: NewVec(uint32(9), uint32(9)), to: NewVec(uint32(0), uint32(0)), traversal: GridBresenham,
+			expected: []Vec[uint32]{{9, 9}, {0, 0}}},
+		{name: "longWayInside", from: NewVec(uint32(2), uint32(0)), to: NewVec(uint32(5), uint32(0)), traversal: GridSupercover,
+			expected: []Vec[uint32]{{2, 0}, {3, 0}, {4, 0}, {5, 0}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := slices.Collect(GridCellsWrapped(tc.from, tc.to, size, tc.traversal))
+			if !slices.Equal(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestGridCells_StopsEarly(t *testing.T) {
+	for _, traversal := range []GridTraversal{GridSupercover, GridBresenham} {
+		n := 0
+		for range GridCells(NewVec(0, 0), NewVec(100, 37), traversal) {
+			if n++; n == 3 {
+				break
+			}
+		}
+		if n != 3 {
+			t.Errorf("traversal %d: expected to stop after 3 cells, got %d", traversal, n)
+		}
+	}
+}
+
+func TestGridCells_NonFinite(t *testing.T) {
+	origin := NewVec(0.5, 0.5)
+	for _, traversal := range []GridTraversal{GridSupercover, GridBresenham} {
+		testCases := []struct {
+			name  string
+			cells iter.Seq[Vec[uint32]]
+		}{
+			{name: "nanEnd", cells: GridCells(origin, NewVec(math.NaN(), 3), traversal)},
+			{name: "infStart", cells: GridCells(NewVec(math.Inf(-1), 0), origin, traversal)},
+			{name: "wrappedInfEnd", cells: GridCellsWrapped(origin, NewVec(3, math.Inf(1)), NewVec(8.0, 8), traversal)},
+			{name: "wrappedInfSize", cells: GridCellsWrapped(origin, NewVec(3.0, 3), NewVec(math.Inf(1), 8), traversal)},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := slices.Collect(tc.cells); len(got) != 0 {
+					t.Errorf("traversal %d: expected no cells, got %v", traversal, got)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Ray is a half-line starting at Origin and running along Direction. Points on
+// the ray are Origin + Direction*t for t >= 0, so Direction also sets the unit
+// of t: with Direction equal to a movement delta, t = 1 is the end of the move.
+type Ray[T Numeric] struct {
+	Origin    Vec[T]
+	Direction Vec[T]
+}
+
+// RayHit describes where a ray crosses a box.
+type RayHit[T Numeric] struct {
+	// Entry and Exit are the ray parameters at which the ray enters and leaves
+	// the box. Entry is 0 when the origin already lies in the box; Exit is +Inf
+	// when the direction is zero.
+	Entry, Exit float64
+	// Point is the ray position at Entry, rounded to the grid for integer types.
+	Point Vec[T]
+	// Normal is the outward axis-aligned normal of the face the ray enters
+	// through, with components -1, 0 or 1 (-1 stored in two's complement for
+	// unsigned types). It is the zero vector when the origin is inside the box.
+	Normal Vec[T]
+}
+
+// NewRay creates a ray from origin along direction.
+func NewRay[T Numeric](origin, direction Vec[T]) Ray[T] {
+	return Ray[T]{Origin: origin, Direction: direction}
+}
+
+// String formats the ray as "origin->direction".
+func (r Ray[T]) String() string { return fmt.Sprintf("%v->%v", r.Origin, r.Direction) }
+
+// At returns the point at parameter t, rounded to the grid for integer types.
+func (r Ray[T]) At(t float64) Vec[T] {
+	o, d := signedFloat64Vec(r.Origin), signedFloat64Vec(r.Direction)
+	return roundToVec[T](Vec[float64]{o.X + d.X*t, o.Y + d.Y*t})
+}
+
+// RayAABB intersects ray with the closed box using the slab method. The boolean
+// is false when the ray misses; grazing an edge or corner counts as a hit.
+//
+// A zero direction component means the ray runs parallel to that pair of faces,
+// so it hits only if the origin lies between them. When the ray enters through
+// a corner, the X face wins the tie for Normal.
+func RayAABB[T Numeric](ray Ray[T], box AABB[T]) (RayHit[T], bool) {
+	o, d := signedFloat64Vec(ray.Origin), signedFloat64Vec(ray.Direction)
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+
+	entry, exit, normal, ok := slabIntersect(o, d, minV, maxV, false)
+	if !ok || exit < max(entry, 0) {
+		return RayHit[T]{}, false
+	}
+	if entry < 0 {
+		// początek promienia leży w pudełku
+		entry, normal = 0, Vec[float64]{}
+	}
+	return RayHit[T]{
+		Entry:  entry,
+		Exit:   exit,
+		Point:  ray.At(entry),
+		Normal: roundToVec[T](normal),
+	}, true
+}
+
+// slabIntersect returns the parameter interval in which o + d*t lies within the
+// box [minV, maxV], and the outward normal of the face entered at entry. ok is
+// false when a parallel axis already rules the box out; with open set, a
+// parallel axis must lie strictly between the faces, so mere touching misses.
+func slabIntersect(o, d, minV, maxV Vec[float64], open bool) (entry, exit float64, normal Vec[float64], ok bool) {
+	entry, exit = math.Inf(-1), math.Inf(1)
+	slabs := [2]struct{ o, d, lo, hi float64 }{
+		{o.X, d.X, minV.X, maxV.X},
+		{o.Y, d.Y, minV.Y, maxV.Y},
+	}
+	for axis, s := range slabs {
+		if s.d == 0 {
+			if s.o < s.lo || s.o > s.hi || (open && (s.o == s.lo || s.o == s.hi)) {
+				return 0, 0, Vec[float64]{}, false
+			}
+			continue
+		}
+		tNear, tFar := (s.lo-s.o)/s.d, (s.hi-s.o)/s.d
+		side := -1.0
+		if tNear > tFar {
+			tNear, tFar, side = tFar, tNear, 1
+		}
+		if tNear > entry {
+			entry = tNear
+			normal = Vec[float64]{}
+			if axis == 0 {
+				normal.X = side
+			} else {
+				normal.Y = side
+			}
+		}
+		exit = min(exit, tFar)
+	}
+	return entry, exit, normal, true
+}
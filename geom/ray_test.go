@@ -0,0 +1,66 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRayAABB(t *testing.T) {
+	runRayAABBTest[int](t, "int")
+	runRayAABBTest[uint32](t, "uint32")
+	runRayAABBTest[float64](t, "float64")
+}
+
+func runRayAABBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		minusOne := int32(-1)
+		neg := T(minusOne)
+		box := NewAABB(v(10, 10), v(20, 20))
+
+		testCases := []struct {
+			name        string
+			ray         Ray[T]
+			hit         bool
+			entry, exit float64
+			point       Vec[T]
+			normal      Vec[T]
+		}{
+			{name: "fromLeft", ray: NewRay(v(0, 15), v(1, 0)), hit: true, entry: 10, exit: 20, point: v(10, 15), normal: v(neg, 0)},
+			{name: "fromBelow", ray: NewRay(v(15, 30), v(0, neg)), hit: true, entry: 10, exit: 20, point: v(15, 20), normal: v(0, 1)},
+			{name: "diagonal", ray: NewRay(v(0, 5), v(2, 1)), hit: true, entry: 5, exit: 10, point: v(10, 10), normal: v(neg, 0)},
+			{name: "scaledDirection", ray: NewRay(v(0, 15), v(20, 0)), hit: true, entry: 0.5, exit: 1, point: v(10, 15), normal: v(neg, 0)},
+			{name: "grazesEdge", ray: NewRay(v(0, 10), v(1, 0)), hit: true, entry: 10, exit: 20, point: v(10, 10), normal: v(neg, 0)},
+			{name: "parallelOutside", ray: NewRay(v(0, 25), v(1, 0))},
+			{name: "pointsAway", ray: NewRay(v(0, 15), v(neg, 0))},
+			{name: "missesCorner", ray: NewRay(v(0, 0), v(1, 3))},
+			{name: "originInside", ray: NewRay(v(12, 15), v(1, 0)), hit: true, entry: 0, exit: 8, point: v(12, 15)},
+			{name: "zeroInside", ray: NewRay(v(12, 15), v(0, 0)), hit: true, entry: 0, exit: math.Inf(1), point: v(12, 15)},
+			{name: "zeroOutside", ray: NewRay(v(5, 15), v(0, 0))},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got, ok := RayAABB(tc.ray, box)
+				if ok != tc.hit {
+					t.Fatalf("expected hit=%v, got %v (%+v)", tc.hit, ok, got)
+				}
+				if !ok {
+					return
+				}
+				if got.Entry != tc.entry || got.Exit != tc.exit {
+					t.Errorf("expected entry/exit %v/%v, got %v/%v", tc.entry, tc.exit, got.Entry, got.Exit)
+				}
+				if got.Point != tc.point || got.Normal != tc.normal {
+					t.Errorf("expected point %v normal %v, got %v %v", tc.point, tc.normal, got.Point, got.Normal)
+				}
+			})
+		}
+	})
+}
+
+func TestRay_At(t *testing.T) {
+	r := NewRay(NewVec(1, 1), NewVec(3, 2))
+	if got := r.At(0.5); got != NewVec(3, 2) {
+		t.Errorf("expected (3,2) after rounding (2.5,2), got %v", got)
+	}
+}
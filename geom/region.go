@@ -0,0 +1,211 @@
+package geom
+
+import (
+	"slices"
+	"strings"
+)
+
+// Region is an area made of non-overlapping AABBs. It is always kept in a
+// canonical form: maximal horizontal strips, each split into disjoint boxes,
+// with vertically adjacent boxes of equal span merged. Two regions covering the
+// same area therefore hold identical boxes and compare equal with Equals.
+//
+// Boxes are treated as areas: degenerate (zero-width or zero-height) boxes are
+// dropped, and boxes that only share an edge do not overlap.
+type Region[T Numeric] struct {
+	boxes []AABB[T]
+}
+
+type regionOp int
+
+const (
+	regionUnion regionOp = iota
+	regionIntersect
+	regionSubtract
+)
+
+// regionStrip is a horizontal band [y0,y1] covered by sorted disjoint x-intervals.
+type regionStrip[T Numeric] struct {
+	y0, y1 T
+	xs     [][2]T
+}
+
+// NewRegion builds the region covered by the union of boxes.
+func NewRegion[T Numeric](boxes ...AABB[T]) Region[T] {
+	return Region[T]{boxes: combineRegions(boxes, nil, regionUnion)}
+}
+
+// AABBs returns a copy of the boxes forming the canonical region.
+func (r Region[T]) AABBs() []AABB[T] {
+	return slices.Clone(r.boxes)
+}
+
+// IsEmpty reports whether the region covers no area.
+func (r Region[T]) IsEmpty() bool {
+	return len(r.boxes) == 0
+}
+
+// Equals reports whether r and other cover exactly the same area.
+func (r Region[T]) Equals(other Region[T]) bool {
+	return slices.Equal(r.boxes, other.boxes)
+}
+
+// String formats the region as its boxes, e.g. "[{(0,0) (2,2)} {(4,0) (6,2)}]".
+func (r Region[T]) String() string {
+	parts := make([]string, len(r.boxes))
+	for i, box := range r.boxes {
+		parts[i] = box.String()
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// Union returns the area covered by r or other.
+func (r Region[T]) Union(other Region[T]) Region[T] {
+	return Region[T]{boxes: combineRegions(r.boxes, other.boxes, regionUnion)}
+}
+
+// Intersect returns the area covered by both r and other.
+func (r Region[T]) Intersect(other Region[T]) Region[T] {
+	return Region[T]{boxes: combineRegions(r.boxes, other.boxes, regionIntersect)}
+}
+
+// Subtract returns the area covered by r but not by other.
+func (r Region[T]) Subtract(other Region[T]) Region[T] {
+	return Region[T]{boxes: combineRegions(r.boxes, other.boxes, regionSubtract)}
+}
+
+// Area returns the total area covered by the region. Fixed areas are in real
+// units, so they overflow once they pass 32768.
+func (r Region[T]) Area() T {
+	var area T
+	for _, box := range r.boxes {
+		area += mulTo(box.BottomRight.X-box.TopLeft.X, box.BottomRight.Y-box.TopLeft.Y)
+	}
+	return area
+}
+
+// Bounds returns the smallest AABB enclosing the region, or a zero box when it is empty.
+func (r Region[T]) Bounds() AABB[T] {
+	if len(r.boxes) == 0 {
+		return AABB[T]{}
+	}
+	bounds := r.boxes[0]
+	for _, box := range r.boxes[1:] {
+		bounds.TopLeft.X = min(bounds.TopLeft.X, box.TopLeft.X)
+		bounds.TopLeft.Y = min(bounds.TopLeft.Y, box.TopLeft.Y)
+		bounds.BottomRight.X = max(bounds.BottomRight.X, box.BottomRight.X)
+		bounds.BottomRight.Y = max(bounds.BottomRight.Y, box.BottomRight.Y)
+	}
+	return bounds
+}
+
+// Contains reports whether box lies entirely within the region.
+func (r Region[T]) Contains(box AABB[T]) bool {
+	return NewRegion(box).Subtract(r).IsEmpty()
+}
+
+// ContainsVec reports whether v lies inside the region or on its boundary.
+func (r Region[T]) ContainsVec(v Vec[T]) bool {
+	for _, box := range r.boxes {
+		if box.IntersectsVec(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// combineRegions sweeps the horizontal strips formed by all box edges and
+// applies op to the x-intervals covered by a and b within each strip.
+func combineRegions[T Numeric](a, b []AABB[T], op regionOp) []AABB[T] {
+	ys := make([]T, 0, 2*(len(a)+len(b)))
+	for _, box := range slices.Concat(a, b) {
+		if isDegenerateBox(box) {
+			continue
+		}
+		ys = append(ys, box.TopLeft.Y, box.BottomRight.Y)
+	}
+	slices.Sort(ys)
+	ys = slices.Compact(ys)
+
+	var strips []regionStrip[T]
+	for i := 0; i+1 < len(ys); i++ {
+		y0, y1 := ys[i], ys[i+1]
+		xs := combineIntervals(stripIntervals(a, y0, y1), stripIntervals(b, y0, y1), op)
+		if len(xs) == 0 {
+			continue
+		}
+		if n := len(strips); n > 0 && strips[n-1].y1 == y0 && slices.Equal(strips[n-1].xs, xs) {
+			strips[n-1].y1 = y1
+			continue
+		}
+		strips = append(strips, regionStrip[T]{y0: y0, y1: y1, xs: xs})
+	}
+
+	var boxes []AABB[T]
+	for _, strip := range strips {
+		for _, x := range strip.xs {
+			boxes = append(boxes, NewAABB(NewVec(x[0], strip.y0), NewVec(x[1], strip.y1)))
+		}
+	}
+	return boxes
+}
+
+// stripIntervals returns the x-intervals of boxes spanning the whole band [y0,y1].
+func stripIntervals[T Numeric](boxes []AABB[T], y0, y1 T) [][2]T {
+	var xs [][2]T
+	for _, box := range boxes {
+		if isDegenerateBox(box) || box.TopLeft.Y > y0 || box.BottomRight.Y < y1 {
+			continue
+		}
+		xs = append(xs, [2]T{box.TopLeft.X, box.BottomRight.X})
+	}
+	return xs
+}
+
+// combineIntervals applies op to two unordered interval lists and returns
+// sorted, disjoint and maximally merged intervals.
+func combineIntervals[T Numeric](a, b [][2]T, op regionOp) [][2]T {
+	edges := make([]T, 0, 2*(len(a)+len(b)))
+	for _, iv := range slices.Concat(a, b) {
+		edges = append(edges, iv[0], iv[1])
+	}
+	slices.Sort(edges)
+	edges = slices.Compact(edges)
+
+	var out [][2]T
+	for i := 0; i+1 < len(edges); i++ {
+		x0, x1 := edges[i], edges[i+1]
+		inA, inB := coversInterval(a, x0, x1), coversInterval(b, x0, x1)
+		var keep bool
+		switch op {
+		case regionUnion:
+			keep = inA || inB
+		case regionIntersect:
+			keep = inA && inB
+		case regionSubtract:
+			keep = inA && !inB
+		}
+		if !keep {
+			continue
+		}
+		if n := len(out); n > 0 && out[n-1][1] == x0 {
+			out[n-1][1] = x1
+			continue
+		}
+		out = append(out, [2]T{x0, x1})
+	}
+	return out
+}
+
+func coversInterval[T Numeric](intervals [][2]T, x0, x1 T) bool {
+	for _, iv := range intervals {
+		if iv[0] <= x0 && iv[1] >= x1 {
+			return true
+		}
+	}
+	return false
+}
+
+func isDegenerateBox[T Numeric](box AABB[T]) bool {
+	return box.BottomRight.X <= box.TopLeft.X || box.BottomRight.Y <= box.TopLeft.Y
+}
@@ -0,0 +1,133 @@
+package geom
+
+import "testing"
+
+func TestRegion_Normalization(t *testing.T) {
+	runRegionNormalizationTest[int](t, "int")
+	runRegionNormalizationTest[uint32](t, "uint32")
+	runRegionNormalizationTest[float64](t, "float64")
+}
+
+func runRegionNormalizationTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		mk := func(x1, y1, x2, y2 T) AABB[T] {
+			return NewAABB(NewVec(x1, y1), NewVec(x2, y2))
+		}
+
+		// dwa sąsiednie kwadraty i ich nakładająca się kopia sklejają się w jeden prostokąt
+		r := NewRegion(mk(0, 0, 2, 2), mk(2, 0, 4, 2), mk(1, 0, 3, 2), mk(5, 5, 5, 9))
+		want := []AABB[T]{mk(0, 0, 4, 2)}
+		if got := r.AABBs(); len(got) != 1 || got[0] != want[0] {
+			t.Fatalf("normalized region = %v, want %v", got, want)
+		}
+
+		stacked := NewRegion(mk(0, 2, 4, 4), mk(0, 0, 4, 2))
+		if !stacked.Equals(NewRegion(mk(0, 0, 4, 4))) {
+			t.Errorf("vertically adjacent boxes should merge, got %v", stacked)
+		}
+
+		if !NewRegion[T]().IsEmpty() {
+			t.Errorf("empty region should be empty")
+		}
+	})
+}
+
+func TestRegion_BooleanOperations(t *testing.T) {
+	runRegionBooleanOperationsTest[int](t, "int")
+	runRegionBooleanOperationsTest[uint32](t, "uint32")
+	runRegionBooleanOperationsTest[float64](t, "float64")
+}
+
+func runRegionBooleanOperationsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		mk := func(x1, y1, x2, y2 T) AABB[T] {
+			return NewAABB(NewVec(x1, y1), NewVec(x2, y2))
+		}
+		a := NewRegion(mk(0, 0, 4, 4))
+		b := NewRegion(mk(2, 2, 6, 6))
+
+		testCases := []struct {
+			name string
+			got  Region[T]
+			want Region[T]
+			area T
+		}{
+			{
+				name: "union",
+				got:  a.Union(b),
+				want: NewRegion(mk(0, 0, 4, 2), mk(0, 2, 6, 4), mk(2, 4, 6, 6)),
+				area: T(28),
+			},
+			{
+				name: "intersect",
+				got:  a.Intersect(b),
+				want: NewRegion(mk(2, 2, 4, 4)),
+				area: T(4),
+			},
+			{
+				name: "subtract",
+				got:  a.Subtract(b),
+				want: NewRegion(mk(0, 0, 4, 2), mk(0, 2, 2, 4)),
+				area: T(12),
+			},
+			{
+				name: "subtractHole",
+				got:  NewRegion(mk(0, 0, 6, 6)).Subtract(NewRegion(mk(2, 2, 4, 4))),
+				want: NewRegion(mk(0, 0, 6, 2), mk(0, 2, 2, 4), mk(4, 2, 6, 4), mk(0, 4, 6, 6)),
+				area: T(32),
+			},
+			{
+				name: "intersectTouching",
+				got:  a.Intersect(NewRegion(mk(4, 0, 8, 4))),
+				want: NewRegion[T](),
+				area: T(0),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if !tc.got.Equals(tc.want) {
+					t.Errorf("got %v, want %v", tc.got, tc.want)
+				}
+				if area := tc.got.Area(); area != tc.area {
+					t.Errorf("area = %v, want %v", area, tc.area)
+				}
+			})
+		}
+	})
+}
+
+func TestRegion_Contains(t *testing.T) {
+	runRegionContainsTest[int](t, "int")
+	runRegionContainsTest[uint32](t, "uint32")
+	runRegionContainsTest[float64](t, "float64")
+}
+
+func runRegionContainsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		mk := func(x1, y1, x2, y2 T) AABB[T] {
+			return NewAABB(NewVec(x1, y1), NewVec(x2, y2))
+		}
+		// kształt litery L
+		r := NewRegion(mk(0, 0, 6, 2), mk(0, 2, 2, 6))
+
+		if !r.Contains(mk(1, 1, 5, 2)) {
+			t.Errorf("box within the foot should be contained")
+		}
+		if !r.Contains(mk(0, 1, 2, 5)) {
+			t.Errorf("box spanning two region boxes should be contained")
+		}
+		if r.Contains(mk(1, 1, 3, 3)) {
+			t.Errorf("box reaching into the notch should not be contained")
+		}
+		if !r.ContainsVec(NewVec(T(2), T(4))) {
+			t.Errorf("boundary point should be contained")
+		}
+		if r.ContainsVec(NewVec(T(4), T(4))) {
+			t.Errorf("notch point should not be contained")
+		}
+		if got, want := r.Bounds(), mk(0, 0, 6, 6); got != want {
+			t.Errorf("bounds = %v, want %v", got, want)
+		}
+	})
+}
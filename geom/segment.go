@@ -0,0 +1,243 @@
+package geom
+
+import (
+	"fmt"
+	"math"
+)
+
+// Segment is a closed line segment between endpoints A and B.
+type Segment[T Numeric] struct {
+	A Vec[T] `json:"a"`
+	B Vec[T] `json:"b"`
+}
+
+// SegmentIntersectionKind classifies how two segments meet.
+type SegmentIntersectionKind int
+
+const (
+	// SegmentsDisjoint means the segments share no point.
+	SegmentsDisjoint SegmentIntersectionKind = iota
+	// SegmentsPoint means the segments cross or touch in exactly one point.
+	SegmentsPoint
+	// SegmentsOverlap means the segments are collinear and share a sub-segment.
+	SegmentsOverlap
+)
+
+// SegmentIntersection describes the result of Segment.Intersect.
+// Point is set for SegmentsPoint, Overlap for SegmentsOverlap.
+type SegmentIntersection[T Numeric] struct {
+	Kind    SegmentIntersectionKind
+	Point   Vec[T]
+	Overlap Segment[T]
+}
+
+// NewSegment constructs a segment from a to b.
+func NewSegment[T Numeric](a, b Vec[T]) Segment[T] {
+	return Segment[T]{A: a, B: b}
+}
+
+// String formats the segment as "[(x,y) (x,y)]".
+func (s Segment[T]) String() string {
+	return fmt.Sprintf("[%v %v]", s.A, s.B)
+}
+
+// Length returns the Euclidean length of the segment, rounded up for integer types.
+func (s Segment[T]) Length() T {
+	a, b := signedFloat64Vec(s.A), signedFloat64Vec(s.B)
+	return lengthTo[T](math.Hypot(b.X-a.X, b.Y-a.Y))
+}
+
+// BoundingAABB returns the smallest AABB containing both endpoints.
+func (s Segment[T]) BoundingAABB() AABB[T] {
+	return boundingAABBOf(s.A, s.B)
+}
+
+// Intersect reports whether and how s meets other. Endpoints count as part of
+// the segment, so touching segments intersect in a point. For integer types the
+// crossing point is rounded to the nearest grid position.
+func (s Segment[T]) Intersect(other Segment[T]) SegmentIntersection[T] {
+	// klasyfikacja opiera się na dokładnych predykatach, więc prawie współliniowe
+	// odcinki nie zmieniają wyniku przez błędy zaokrągleń
+	o1 := Orient2D(s.A, s.B, other.A)
+	o2 := Orient2D(s.A, s.B, other.B)
+	o3 := Orient2D(other.A, other.B, s.A)
+	o4 := Orient2D(other.A, other.B, s.B)
+
+	if o1 == 0 && o2 == 0 && o3 == 0 && o4 == 0 {
+		return collinearIntersection(s, other)
+	}
+	if o1*o2 > 0 || o3*o4 > 0 {
+		return SegmentIntersection[T]{}
+	}
+
+	switch {
+	case o1 == 0:
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: other.A}
+	case o2 == 0:
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: other.B}
+	case o3 == 0:
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: s.A}
+	case o4 == 0:
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: s.B}
+	}
+
+	p, r := s.floatForm()
+	q, d := other.floatForm()
+	qp := Vec[float64]{q.X - p.X, q.Y - p.Y}
+	t := min(max(crossFloat64(qp, d)/crossFloat64(r, d), 0), 1)
+	return SegmentIntersection[T]{
+		Kind:  SegmentsPoint,
+		Point: roundToVec[T](Vec[float64]{p.X + r.X*t, p.Y + r.Y*t}),
+	}
+}
+
+// Intersects reports whether s and other share at least one point.
+func (s Segment[T]) Intersects(other Segment[T]) bool {
+	return s.Intersect(other).Kind != SegmentsDisjoint
+}
+
+// ClipAABB clips s to box using the Liang–Barsky algorithm and returns the part
+// inside the box. The boolean is false when the segment misses the box entirely.
+func (s Segment[T]) ClipAABB(box AABB[T]) (Segment[T], bool) {
+	p, r := s.floatForm()
+	minV, maxV := signedFloat64Vec(box.TopLeft), signedFloat64Vec(box.BottomRight)
+
+	t0, t1 := 0.0, 1.0
+	edges := [4][2]float64{
+		{-r.X, p.X - minV.X},
+		{r.X, maxV.X - p.X},
+		{-r.Y, p.Y - minV.Y},
+		{r.Y, maxV.Y - p.Y},
+	}
+	for _, e := range edges {
+		dir, dist := e[0], e[1]
+		if dir == 0 {
+			if dist < 0 {
+				return Segment[T]{}, false
+			}
+			continue
+		}
+		t := dist / dir
+		if dir < 0 {
+			if t > t1 {
+				return Segment[T]{}, false
+			}
+			t0 = max(t0, t)
+		} else {
+			if t < t0 {
+				return Segment[T]{}, false
+			}
+			t1 = min(t1, t)
+		}
+	}
+
+	clipped := Segment[T]{A: s.A, B: s.B}
+	if t0 > 0 {
+		clipped.A = roundToVec[T](Vec[float64]{p.X + r.X*t0, p.Y + r.Y*t0})
+	}
+	if t1 < 1 {
+		clipped.B = roundToVec[T](Vec[float64]{p.X + r.X*t1, p.Y + r.Y*t1})
+	}
+	return clipped, true
+}
+
+// ClosestPoint returns the point of s nearest to v.
+func (s Segment[T]) ClosestPoint(v Vec[T]) Vec[T] {
+	p, r := s.floatForm()
+	t := s.closestParam(signedFloat64Vec(v))
+	switch t {
+	case 0:
+		return s.A
+	case 1:
+		return s.B
+	}
+	return roundToVec[T](Vec[float64]{p.X + r.X*t, p.Y + r.Y*t})
+}
+
+// DistanceTo returns the Euclidean distance from v to the nearest point of s,
+// rounded up for integer types.
+func (s Segment[T]) DistanceTo(v Vec[T]) T {
+	p, r := s.floatForm()
+	pt := signedFloat64Vec(v)
+	t := s.closestParam(pt)
+	return lengthTo[T](math.Hypot(p.X+r.X*t-pt.X, p.Y+r.Y*t-pt.Y))
+}
+
+// floatForm returns the start point and direction of s as float64 vectors.
+func (s Segment[T]) floatForm() (start, dir Vec[float64]) {
+	start = signedFloat64Vec(s.A)
+	end := signedFloat64Vec(s.B)
+	return start, Vec[float64]{end.X - start.X, end.Y - start.Y}
+}
+
+// closestParam returns the parameter in [0,1] of the point on s nearest to pt.
+func (s Segment[T]) closestParam(pt Vec[float64]) float64 {
+	p, r := s.floatForm()
+	lenSq := dotFloat64(r, r)
+	if lenSq == 0 {
+		return 0
+	}
+	t := dotFloat64(Vec[float64]{pt.X - p.X, pt.Y - p.Y}, r) / lenSq
+	return min(max(t, 0), 1)
+}
+
+func collinearIntersection[T Numeric](s, other Segment[T]) SegmentIntersection[T] {
+	p, r := s.floatForm()
+	q, d := other.floatForm()
+
+	lenSq := dotFloat64(r, r)
+	if lenSq == 0 {
+		// s jest punktem – sprawdź, czy leży na other
+		if !onSegment(other.A, other.B, s.A) {
+			return SegmentIntersection[T]{}
+		}
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: s.A}
+	}
+
+	t0 := dotFloat64(Vec[float64]{q.X - p.X, q.Y - p.Y}, r) / lenSq
+	t1 := t0 + dotFloat64(d, r)/lenSq
+	lo, hi := max(min(t0, t1), 0), min(max(t0, t1), 1)
+	if lo > hi {
+		return SegmentIntersection[T]{}
+	}
+
+	at := func(t float64) Vec[T] {
+		switch t {
+		case 0:
+			return s.A
+		case 1:
+			return s.B
+		}
+		return roundToVec[T](Vec[float64]{p.X + r.X*t, p.Y + r.Y*t})
+	}
+	if lo == hi {
+		return SegmentIntersection[T]{Kind: SegmentsPoint, Point: at(lo)}
+	}
+	return SegmentIntersection[T]{Kind: SegmentsOverlap, Overlap: NewSegment(at(lo), at(hi))}
+}
+
+// onSegment reports whether p lies on the closed segment a-b.
+func onSegment[T Numeric](a, b, p Vec[T]) bool {
+	if Orient2D(a, b, p) != 0 {
+		return false
+	}
+	pf, af, bf := signedFloat64Vec(p), signedFloat64Vec(a), signedFloat64Vec(b)
+	return pf.X >= min(af.X, bf.X) && pf.X <= max(af.X, bf.X) &&
+		pf.Y >= min(af.Y, bf.Y) && pf.Y <= max(af.Y, bf.Y)
+}
+
+// boundingAABBOf returns the smallest AABB containing all points. Unsigned
+// components are compared as signed, so 0xFFFF_FFF8 extends the box to -8.
+func boundingAABBOf[T Numeric](points ...Vec[T]) AABB[T] {
+	if len(points) == 0 {
+		return AABB[T]{}
+	}
+	minV, maxV := points[0], points[0]
+	for _, p := range points[1:] {
+		minV.X = lowerSigned(minV.X, p.X)
+		minV.Y = lowerSigned(minV.Y, p.Y)
+		maxV.X = upperSigned(maxV.X, p.X)
+		maxV.Y = upperSigned(maxV.Y, p.Y)
+	}
+	return NewAABB(minV, maxV)
+}
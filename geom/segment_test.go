@@ -0,0 +1,130 @@
+package geom
+
+import "testing"
+
+func TestSegment_Intersect(t *testing.T) {
+	runSegmentIntersectTest[int](t, "int")
+	runSegmentIntersectTest[uint32](t, "uint32")
+	runSegmentIntersectTest[float64](t, "float64")
+}
+
+func runSegmentIntersectTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		seg := func(x1, y1, x2, y2 T) Segment[T] {
+			return NewSegment(NewVec(x1, y1), NewVec(x2, y2))
+		}
+
+		testCases := []struct {
+			name    string
+			s1, s2  Segment[T]
+			kind    SegmentIntersectionKind
+			point   Vec[T]
+			overlap Segment[T]
+		}{
+			{name: "cross", s1: seg(0, 0, 4, 4), s2: seg(0, 4, 4, 0), kind: SegmentsPoint, point: NewVec(T(2), T(2))},
+			{name: "touchEndpoint", s1: seg(0, 0, 2, 2), s2: seg(2, 2, 4, 0), kind: SegmentsPoint, point: NewVec(T(2), T(2))},
+			{name: "tJunction", s1: seg(0, 0, 4, 0), s2: seg(2, 0, 2, 3), kind: SegmentsPoint, point: NewVec(T(2), T(0))},
+			{name: "missing", s1: seg(0, 0, 1, 1), s2: seg(3, 0, 0, 3), kind: SegmentsDisjoint},
+			{name: "parallel", s1: seg(0, 0, 4, 0), s2: seg(0, 1, 4, 1), kind: SegmentsDisjoint},
+			{name: "collinearApart", s1: seg(0, 0, 2, 0), s2: seg(3, 0, 5, 0), kind: SegmentsDisjoint},
+			{name: "collinearTouch", s1: seg(0, 0, 2, 0), s2: seg(2, 0, 5, 0), kind: SegmentsPoint, point: NewVec(T(2), T(0))},
+			{name: "overlap", s1: seg(0, 0, 4, 0), s2: seg(6, 0, 2, 0), kind: SegmentsOverlap, overlap: seg(2, 0, 4, 0)},
+			{name: "containedOverlap", s1: seg(0, 0, 6, 6), s2: seg(2, 2, 4, 4), kind: SegmentsOverlap, overlap: seg(2, 2, 4, 4)},
+			{name: "pointOnSegment", s1: seg(1, 1, 1, 1), s2: seg(0, 0, 2, 2), kind: SegmentsPoint, point: NewVec(T(1), T(1))},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got := tc.s1.Intersect(tc.s2)
+				if got.Kind != tc.kind {
+					t.Fatalf("%v ∩ %v kind = %v, want %v", tc.s1, tc.s2, got.Kind, tc.kind)
+				}
+				if got.Point != tc.point {
+					t.Errorf("point = %v, want %v", got.Point, tc.point)
+				}
+				if got.Overlap != tc.overlap {
+					t.Errorf("overlap = %v, want %v", got.Overlap, tc.overlap)
+				}
+				if tc.s2.Intersects(tc.s1) != (tc.kind != SegmentsDisjoint) {
+					t.Errorf("Intersects is not symmetric for %v and %v", tc.s1, tc.s2)
+				}
+			})
+		}
+	})
+}
+
+func TestSegment_ClipAABB(t *testing.T) {
+	runSegmentClipAABBTest[int](t, "int")
+	runSegmentClipAABBTest[uint32](t, "uint32")
+	runSegmentClipAABBTest[float64](t, "float64")
+}
+
+func runSegmentClipAABBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		box := NewAABB(NewVec(T(2), T(2)), NewVec(T(6), T(6)))
+		seg := func(x1, y1, x2, y2 T) Segment[T] {
+			return NewSegment(NewVec(x1, y1), NewVec(x2, y2))
+		}
+
+		testCases := []struct {
+			name string
+			in   Segment[T]
+			want Segment[T]
+			ok   bool
+		}{
+			{name: "inside", in: seg(3, 3, 5, 4), want: seg(3, 3, 5, 4), ok: true},
+			{name: "horizontalThrough", in: seg(0, 4, 8, 4), want: seg(2, 4, 6, 4), ok: true},
+			{name: "diagonalThrough", in: seg(0, 0, 8, 8), want: seg(2, 2, 6, 6), ok: true},
+			{name: "enteringOnly", in: seg(4, 4, 4, 10), want: seg(4, 4, 4, 6), ok: true},
+			{name: "outsideParallel", in: seg(0, 0, 8, 0), ok: false},
+			{name: "outsideDiagonal", in: seg(0, 3, 3, 0), ok: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got, ok := tc.in.ClipAABB(box)
+				if ok != tc.ok {
+					t.Fatalf("clip %v ok = %v, want %v", tc.in, ok, tc.ok)
+				}
+				if ok && got != tc.want {
+					t.Errorf("clip %v = %v, want %v", tc.in, got, tc.want)
+				}
+			})
+		}
+	})
+}
+
+func TestSegment_ClosestPointAndDistance(t *testing.T) {
+	runSegmentClosestPointTest[int](t, "int")
+	runSegmentClosestPointTest[uint32](t, "uint32")
+	runSegmentClosestPointTest[float64](t, "float64")
+}
+
+func runSegmentClosestPointTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		s := NewSegment(NewVec(T(2), T(2)), NewVec(T(10), T(2)))
+
+		testCases := []struct {
+			name     string
+			point    Vec[T]
+			closest  Vec[T]
+			distance T
+		}{
+			{name: "above", point: NewVec(T(5), T(6)), closest: NewVec(T(5), T(2)), distance: T(4)},
+			{name: "beforeStart", point: NewVec(T(0), T(2)), closest: NewVec(T(2), T(2)), distance: T(2)},
+			{name: "pastEnd", point: NewVec(T(13), T(6)), closest: NewVec(T(10), T(2)), distance: T(5)},
+			{name: "onSegment", point: NewVec(T(7), T(2)), closest: NewVec(T(7), T(2)), distance: T(0)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := s.ClosestPoint(tc.point); got != tc.closest {
+					t.Errorf("closest point to %v = %v, want %v", tc.point, got, tc.closest)
+				}
+				if got := s.DistanceTo(tc.point); got != tc.distance {
+					t.Errorf("distance to %v = %v, want %v", tc.point, got, tc.distance)
+				}
+			})
+		}
+	})
+}
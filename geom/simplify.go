@@ -0,0 +1,193 @@
+package geom
+
+import (
+	"container/heap"
+	"math"
+)
+
+// The simplifiers below only ever drop vertices: every returned point is one of
+// the input points, in the original order, and both endpoints are always kept.
+// The result therefore never leaves the AABB of the original path, which keeps
+// integer paths on their grid. Paths with two or fewer points are returned as a copy.
+
+// SimplifyDouglasPeucker removes points whose perpendicular distance from the
+// simplified path stays within tolerance (Ramer–Douglas–Peucker).
+func SimplifyDouglasPeucker[T Numeric](path []Vec[T], tolerance float64) []Vec[T] {
+	return douglasPeucker(path, func(_ int, dist float64) bool { return dist <= tolerance })
+}
+
+// SimplifyDouglasPeuckerN keeps at most n points, adding the farthest remaining
+// point first until the budget is spent. n is raised to 2 when smaller.
+func SimplifyDouglasPeuckerN[T Numeric](path []Vec[T], n int) []Vec[T] {
+	n = max(n, 2)
+	return douglasPeucker(path, func(kept int, dist float64) bool { return kept >= n || dist == 0 })
+}
+
+// SimplifyVisvalingam repeatedly removes the point forming the smallest triangle
+// with its neighbours while that effective area is below minArea (Visvalingam–Whyatt).
+func SimplifyVisvalingam[T Numeric](path []Vec[T], minArea float64) []Vec[T] {
+	return visvalingam(path, func(_ int, area float64) bool { return area >= minArea })
+}
+
+// SimplifyVisvalingamN removes the least significant points until at most n remain.
+// n is raised to 2 when smaller.
+func SimplifyVisvalingamN[T Numeric](path []Vec[T], n int) []Vec[T] {
+	n = max(n, 2)
+	return visvalingam(path, func(kept int, _ float64) bool { return kept <= n })
+}
+
+// -----------------------------------------------------------------------------
+
+// douglasPeucker refines the path top-down, always splitting the span with the
+// largest deviation, until stop reports that the result is good enough.
+func douglasPeucker[T Numeric](path []Vec[T], stop func(kept int, dist float64) bool) []Vec[T] {
+	if len(path) <= 2 {
+		return append([]Vec[T](nil), path...)
+	}
+	pts := polygonFloat64(path)
+	keep := make([]bool, len(path))
+	keep[0], keep[len(path)-1] = true, true
+	kept := 2
+
+	spans := &simplifyHeap{}
+	pushSpan := func(first, last int) {
+		if idx, dist := farthestPoint(pts, first, last); idx >= 0 {
+			heap.Push(spans, simplifyItem{index: idx, value: -dist, first: first, last: last})
+		}
+	}
+	pushSpan(0, len(path)-1)
+
+	for spans.Len() > 0 {
+		top := (*spans)[0]
+		if stop(kept, -top.value) {
+			break
+		}
+		heap.Pop(spans)
+		keep[top.index] = true
+		kept++
+		pushSpan(top.first, top.index)
+		pushSpan(top.index, top.last)
+	}
+	return selectKept(path, keep)
+}
+
+// farthestPoint returns the index strictly between first and last that lies
+// farthest from segment first–last, or -1 when there is none.
+func farthestPoint(pts []Vec[float64], first, last int) (int, float64) {
+	idx, best := -1, -1.0
+	for i := first + 1; i < last; i++ {
+		if d := pointSegmentDistance(pts[i], pts[first], pts[last]); d > best {
+			idx, best = i, d
+		}
+	}
+	return idx, best
+}
+
+func pointSegmentDistance(p, a, b Vec[float64]) float64 {
+	ab := b.Sub(a)
+	t := 0.0
+	if lenSq := dotFloat64(ab, ab); lenSq > 0 {
+		t = min(max(dotFloat64(p.Sub(a), ab)/lenSq, 0), 1)
+	}
+	return math.Hypot(a.X+ab.X*t-p.X, a.Y+ab.Y*t-p.Y)
+}
+
+// visvalingam removes points bottom-up by effective area until stop reports
+// that the smallest remaining area (or the point count) must be preserved.
+func visvalingam[T Numeric](path []Vec[T], stop func(kept int, area float64) bool) []Vec[T] {
+	n := len(path)
+	if n <= 2 {
+		return append([]Vec[T](nil), path...)
+	}
+	pts := polygonFloat64(path)
+	prev := make([]int, n)
+	next := make([]int, n)
+	version := make([]int, n)
+	keep := make([]bool, n)
+	for i := range n {
+		prev[i], next[i], keep[i] = i-1, i+1, true
+	}
+
+	areas := &simplifyHeap{}
+	for i := 1; i < n-1; i++ {
+		heap.Push(areas, simplifyItem{index: i, value: triangleArea(pts[i-1], pts[i], pts[i+1])})
+	}
+
+	kept := n
+	lastRemoved := 0.0
+	for areas.Len() > 0 {
+		top := heap.Pop(areas).(simplifyItem)
+		if !keep[top.index] || top.version != version[top.index] {
+			continue // nieaktualny wpis
+		}
+		// pole efektywne nie maleje – punkt nie może być mniej istotny od już usuniętych
+		area := max(top.value, lastRemoved)
+		if stop(kept, area) {
+			break
+		}
+		lastRemoved = area
+		i := top.index
+		keep[i] = false
+		kept--
+		p, nx := prev[i], next[i]
+		next[p], prev[nx] = nx, p
+		for _, j := range [2]int{p, nx} {
+			if j == 0 || j == n-1 {
+				continue
+			}
+			version[j]++
+			heap.Push(areas, simplifyItem{
+				index:   j,
+				value:   triangleArea(pts[prev[j]], pts[j], pts[next[j]]),
+				version: version[j],
+			})
+		}
+	}
+	return selectKept(path, keep)
+}
+
+func triangleArea(a, b, c Vec[float64]) float64 {
+	return math.Abs(crossFloat64(b.Sub(a), c.Sub(a))) / 2
+}
+
+func selectKept[T Numeric](path []Vec[T], keep []bool) []Vec[T] {
+	out := make([]Vec[T], 0, len(path))
+	for i, k := range keep {
+		if k {
+			out = append(out, path[i])
+		}
+	}
+	return out
+}
+
+// simplifyItem is a heap entry; first/last bound a Douglas–Peucker span and
+// version lets Visvalingam skip entries made stale by a neighbour's removal.
+type simplifyItem struct {
+	index       int
+	value       float64
+	first, last int
+	version     int
+}
+
+// simplifyHeap is a min-heap on value, breaking ties by index for determinism.
+type simplifyHeap []simplifyItem
+
+func (h simplifyHeap) Len() int { return len(h) }
+
+func (h simplifyHeap) Less(i, j int) bool {
+	if h[i].value != h[j].value {
+		return h[i].value < h[j].value
+	}
+	return h[i].index < h[j].index
+}
+
+func (h simplifyHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *simplifyHeap) Push(x any) { *h = append(*h, x.(simplifyItem)) }
+
+func (h *simplifyHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,79 @@
+package geom
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSimplify(t *testing.T) {
+	runSimplifyTest[int](t, "int")
+	runSimplifyTest[uint32](t, "uint32")
+	runSimplifyTest[float64](t, "float64")
+}
+
+func runSimplifyTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		// schodek z drobnym szumem na odcinkach prostych
+		path := []Vec[T]{v(0, 0), v(2, 1), v(4, 0), v(6, 0), v(8, 1), v(10, 0), v(10, 10), v(12, 10), v(20, 10)}
+
+		testCases := []struct {
+			name string
+			got  []Vec[T]
+			want []Vec[T]
+		}{
+			{name: "dpTolerance", got: SimplifyDouglasPeucker(path, 1), want: []Vec[T]{v(0, 0), v(10, 0), v(10, 10), v(20, 10)}},
+			{name: "dpZeroTolerance", got: SimplifyDouglasPeucker(path, 0), want: []Vec[T]{v(0, 0), v(2, 1), v(4, 0), v(6, 0), v(8, 1), v(10, 0), v(10, 10), v(20, 10)}},
+			{name: "dpCount", got: SimplifyDouglasPeuckerN(path, 3), want: []Vec[T]{v(0, 0), v(10, 0), v(20, 10)}},
+			{name: "dpCountBelowTwo", got: SimplifyDouglasPeuckerN(path, 0), want: []Vec[T]{v(0, 0), v(20, 10)}},
+			{name: "vwArea", got: SimplifyVisvalingam(path, 6), want: []Vec[T]{v(0, 0), v(10, 0), v(10, 10), v(20, 10)}},
+			{name: "vwSmallArea", got: SimplifyVisvalingam(path, 2), want: []Vec[T]{v(0, 0), v(2, 1), v(6, 0), v(8, 1), v(10, 0), v(10, 10), v(20, 10)}},
+			{name: "vwCount", got: SimplifyVisvalingamN(path, 4), want: []Vec[T]{v(0, 0), v(10, 0), v(10, 10), v(20, 10)}},
+			{name: "shortPath", got: SimplifyVisvalingamN(path[:2], 1), want: path[:2]},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if len(tc.got) != len(tc.want) {
+					t.Fatalf("got %v, want %v", tc.got, tc.want)
+				}
+				for i := range tc.got {
+					if tc.got[i] != tc.want[i] {
+						t.Fatalf("got %v, want %v", tc.got, tc.want)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestSimplify_StaysWithinOriginalBounds(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 11))
+	path := make([]Vec[uint32], 500)
+	for i := range path {
+		path[i] = NewVec(uint32(rng.IntN(64)), uint32(rng.IntN(64)))
+	}
+	bounds := boundingAABBOf(path...)
+
+	for name, simplified := range map[string][]Vec[uint32]{
+		"dp":  SimplifyDouglasPeucker(path, 3),
+		"dpN": SimplifyDouglasPeuckerN(path, 50),
+		"vw":  SimplifyVisvalingam(path, 10),
+		"vwN": SimplifyVisvalingamN(path, 50),
+	} {
+		if simplified[0] != path[0] || simplified[len(simplified)-1] != path[len(path)-1] {
+			t.Errorf("%s: endpoints not preserved", name)
+		}
+		for _, p := range simplified {
+			if !bounds.IntersectsVec(p) {
+				t.Errorf("%s: point %v outside original bounds %v", name, p, bounds)
+			}
+		}
+	}
+	if got := len(SimplifyVisvalingamN(path, 50)); got != 50 {
+		t.Errorf("vwN kept %d points, want 50", got)
+	}
+	if got := len(SimplifyDouglasPeuckerN(path, 50)); got != 50 {
+		t.Errorf("dpN kept %d points, want 50", got)
+	}
+}
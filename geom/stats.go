@@ -0,0 +1,170 @@
+package geom
+
+import "math"
+
+// Covariance is the 2x2 covariance matrix of a point set:
+//
+//	| XX XY |
+//	| XY YY |
+//
+// It is the population covariance, i.e. divided by the number of points.
+type Covariance struct {
+	XX, XY, YY float64
+}
+
+// PrincipalAxes describes the spread of a point set found by principal
+// component analysis.
+type PrincipalAxes struct {
+	// Centroid is the mean of the points, kept in float64 like the axes.
+	Centroid Vec[float64]
+	// Major is the unit direction of the largest variance and Minor the one
+	// perpendicular to it. With no preferred direction Major is +X.
+	Major, Minor Vec[float64]
+	// MajorVariance and MinorVariance are the variances along Major and Minor,
+	// i.e. the eigenvalues of the covariance matrix.
+	MajorVariance, MinorVariance float64
+}
+
+// BoundsOf returns the smallest AABB containing all points, or the zero AABB
+// when there are none.
+func BoundsOf[T Numeric](points []Vec[T]) AABB[T] {
+	return boundingAABBOf(points...)
+}
+
+// CentroidOf returns the mean of points, rounded for integer types. Unlike
+// Polygon.Centroid every point has the same weight. It returns the zero vector
+// when there are no points.
+func CentroidOf[T Numeric](points []Vec[T]) Vec[T] {
+	if len(points) == 0 {
+		return Vec[T]{}
+	}
+	return roundToVec[T](meanFloat64(points))
+}
+
+// CovarianceOf returns the covariance matrix of points; it is zero for fewer
+// than two points.
+func CovarianceOf[T Numeric](points []Vec[T]) Covariance {
+	if len(points) < 2 {
+		return Covariance{}
+	}
+	mean := meanFloat64(points)
+	var c Covariance
+	for _, p := range points {
+		d := signedFloat64Vec(p).Sub(mean)
+		c.XX += d.X * d.X
+		c.XY += d.X * d.Y
+		c.YY += d.Y * d.Y
+	}
+	n := float64(len(points))
+	return Covariance{XX: c.XX / n, XY: c.XY / n, YY: c.YY / n}
+}
+
+// PrincipalAxesOf runs principal component analysis on points: the axes are the
+// eigenvectors of the covariance matrix, which for a squad of units tells its
+// heading and how stretched it is.
+func PrincipalAxesOf[T Numeric](points []Vec[T]) PrincipalAxes {
+	if len(points) == 0 {
+		return PrincipalAxes{Major: Vec[float64]{1, 0}, Minor: Vec[float64]{0, 1}}
+	}
+	c := CovarianceOf(points)
+	mid, half := (c.XX+c.YY)/2, math.Hypot((c.XX-c.YY)/2, c.XY)
+	sin, cos := math.Sincos(math.Atan2(2*c.XY, c.XX-c.YY) / 2)
+	return PrincipalAxes{
+		Centroid:      meanFloat64(points),
+		Major:         Vec[float64]{cos, sin},
+		Minor:         Vec[float64]{-sin, cos},
+		MajorVariance: mid + half,
+		MinorVariance: mid - half,
+	}
+}
+
+// MinAreaOBB returns the oriented rectangle of least area enclosing points,
+// found with rotating calipers over their ConvexHull. One side of that
+// rectangle always lies on a hull edge, and the calipers visit every edge in
+// linear time. Rotation is the direction of that edge.
+//
+// For integer types the center is rounded and the half-extents grown to whole
+// units so that the box still encloses every point. Collinear input yields a
+// box with zero height and a single point one with zero extents.
+func MinAreaOBB[T Numeric](points []Vec[T]) OBB[T] {
+	hull := polygonFloat64(ConvexHull(points).Vertices)
+	switch len(hull) {
+	case 0:
+		return OBB[T]{}
+	case 1:
+		return OBB[T]{Center: roundToVec[T](hull[0])}
+	}
+
+	n := len(hull)
+	next := func(i int) int { return (i + 1) % n }
+	var best struct {
+		area, rotation float64
+		center, half   Vec[float64]
+	}
+	best.area = math.Inf(1)
+	right, top, left := 0, 0, 0
+	for i := range n {
+		p := hull[i]
+		u := normalizeFloat64(hull[next(i)].Sub(p))
+		normal := Vec[float64]{-u.Y, u.X}
+		along := func(j int) float64 { return dotFloat64(hull[j].Sub(p), u) }
+		across := func(j int) float64 { return dotFloat64(hull[j].Sub(p), normal) }
+
+		// wskaźniki suwmiarki tylko idą do przodu, stąd czas liniowy
+		for k := 0; k < n && along(next(right)) > along(right); k++ {
+			right = next(right)
+		}
+		if i == 0 {
+			top = right
+		}
+		for k := 0; k < n && across(next(top)) > across(top); k++ {
+			top = next(top)
+		}
+		if i == 0 {
+			left = top
+		}
+		for k := 0; k < n && along(next(left)) < along(left); k++ {
+			left = next(left)
+		}
+
+		minU, maxU, height := along(left), along(right), across(top)
+		if area := (maxU - minU) * height; area < best.area-eps {
+			best.area = area
+			best.rotation = math.Atan2(u.Y, u.X)
+			cu, cv := (minU+maxU)/2, height/2
+			best.center = Vec[float64]{p.X + u.X*cu + normal.X*cv, p.Y + u.Y*cu + normal.Y*cv}
+			best.half = Vec[float64]{(maxU - minU) / 2, height / 2}
+		}
+	}
+
+	if isFloating[T]() {
+		return OBB[T]{
+			Center:      Vec[T]{T(best.center.X), T(best.center.Y)},
+			HalfExtents: Vec[T]{T(best.half.X), T(best.half.Y)},
+			Rotation:    best.rotation,
+		}
+	}
+	// zaokrąglenie środka przesuwa pudełko – nadrabiamy to w półwymiarach
+	center := roundToVec[T](best.center)
+	shift := rotateFloat64(signedFloat64Vec(center).Sub(best.center), -best.rotation)
+	return OBB[T]{
+		Center: center,
+		HalfExtents: Vec[T]{
+			lengthTo[T](best.half.X + math.Abs(shift.X)),
+			lengthTo[T](best.half.Y + math.Abs(shift.Y)),
+		},
+		Rotation: best.rotation,
+	}
+}
+
+// meanFloat64 averages points relative to the first one, which keeps precision
+// for large coordinates.
+func meanFloat64[T Numeric](points []Vec[T]) Vec[float64] {
+	origin := signedFloat64Vec(points[0])
+	var sum Vec[float64]
+	for _, p := range points {
+		sum = sum.Add(signedFloat64Vec(p).Sub(origin))
+	}
+	n := float64(len(points))
+	return Vec[float64]{origin.X + sum.X/n, origin.Y + sum.Y/n}
+}
@@ -0,0 +1,92 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPointStats(t *testing.T) {
+	runPointStatsTest[int](t, "int")
+	runPointStatsTest[uint32](t, "uint32")
+	runPointStatsTest[float64](t, "float64")
+}
+
+func runPointStatsTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		square := []Vec[T]{v(2, 2), v(4, 2), v(2, 4), v(4, 4), v(3, 3)}
+
+		if got := BoundsOf(square); got != NewAABB(v(2, 2), v(4, 4)) {
+			t.Errorf("bounds: expected {(2,2) (4,4)}, got %v", got)
+		}
+		if got := CentroidOf(square); got != v(3, 3) {
+			t.Errorf("centroid: expected (3,3), got %v", got)
+		}
+		if got := CovarianceOf(square); math.Abs(got.XX-0.8) > 1e-9 || math.Abs(got.YY-0.8) > 1e-9 || got.XY != 0 {
+			t.Errorf("covariance: expected {0.8 0 0.8}, got %+v", got)
+		}
+
+		diagonal := []Vec[T]{v(0, 0), v(1, 1), v(2, 2), v(3, 3)}
+		axes := PrincipalAxesOf(diagonal)
+		if !approxEqualVec(axes.Major, NewVec(math.Sqrt2/2, math.Sqrt2/2)) {
+			t.Errorf("major: expected diagonal, got %v", axes.Major)
+		}
+		if math.Abs(axes.MajorVariance-2.5) > 1e-9 || math.Abs(axes.MinorVariance) > 1e-9 {
+			t.Errorf("variances: expected 2.5 and 0, got %v and %v", axes.MajorVariance, axes.MinorVariance)
+		}
+		if !approxEqualVec(axes.Centroid, NewVec(1.5, 1.5)) {
+			t.Errorf("centroid: expected (1.5,1.5), got %v", axes.Centroid)
+		}
+	})
+}
+
+func TestMinAreaOBB(t *testing.T) {
+	runMinAreaOBBTest[int](t, "int")
+	runMinAreaOBBTest[uint32](t, "uint32")
+	runMinAreaOBBTest[float64](t, "float64")
+}
+
+func runMinAreaOBBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		// prostokąt 4√2 x 2√2 obrócony o 45°; AABB ma pole 36, minimalne pole to 16
+		points := []Vec[T]{v(0, 4), v(4, 0), v(6, 2), v(2, 6), v(3, 3), v(2, 3), v(4, 2)}
+		box := MinAreaOBB(points)
+		for _, p := range points {
+			if !box.ContainsVec(p) {
+				t.Errorf("box %v does not contain %v", box, p)
+			}
+		}
+		h := signedFloat64Vec(box.HalfExtents)
+		area := 4 * h.X * h.Y
+		if isFloating[T]() {
+			if math.Abs(area-16) > 1e-9 {
+				t.Errorf("expected area 16, got %v (%v)", area, box)
+			}
+		} else if area > 24 {
+			// półwymiary całkowite zaokrąglone w górę: 2√2→3, √2→2
+			t.Errorf("expected area at most 24, got %v (%v)", area, box)
+		}
+		if r := math.Mod(math.Abs(box.Rotation), math.Pi/2); math.Abs(r-math.Pi/4) > 1e-9 {
+			t.Errorf("expected a 45° rotation, got %v", box.Rotation)
+		}
+
+		testCases := []struct {
+			name     string
+			points   []Vec[T]
+			expected OBB[T]
+		}{
+			{name: "empty", points: nil, expected: OBB[T]{}},
+			{name: "single", points: []Vec[T]{v(5, 7), v(5, 7)}, expected: NewOBB(v(5, 7), v(0, 0), 0)},
+			{name: "collinear", points: []Vec[T]{v(0, 2), v(10, 2), v(4, 2)}, expected: NewOBB(v(5, 2), v(5, 0), 0)},
+			{name: "axisAligned", points: []Vec[T]{v(0, 0), v(8, 0), v(8, 4), v(0, 4)}, expected: NewOBB(v(4, 2), v(4, 2), 0)},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				if got := MinAreaOBB(tc.points); got != tc.expected {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package geom
+
+import "math"
+
+// SweepHit is the first contact of a box moving along a delta.
+type SweepHit[T Numeric] struct {
+	// Time is the fraction of the delta travelled at contact, in [0, 1].
+	Time float64
+	// Travel is how far the moving box can go before contact. Integer components
+	// are truncated towards zero, so applying Travel never causes overlap.
+	Travel Vec[T]
+	// Normal is the outward normal of the obstacle face that was hit, with
+	// components -1, 0 or 1 like RayHit.Normal. It is the zero vector when the
+	// boxes already overlap at Time 0.
+	Normal Vec[T]
+}
+
+// SweepAABB finds the earliest time at which moving, translated by delta,
+// touches obstacle with a closing velocity. Boxes that merely touch or slide
+// along each other's faces do not collide; boxes that already overlap collide
+// at Time 0 with a zero Normal.
+//
+// This is a ray cast of moving's top-left corner against obstacle grown by the
+// size of moving (their Minkowski sum), so thin obstacles cannot be tunnelled
+// through however large delta is.
+func SweepAABB[T Numeric](moving AABB[T], delta Vec[T], obstacle AABB[T]) (SweepHit[T], bool) {
+	return sweep(moving, signedFloat64Vec(delta), delta, obstacle)
+}
+
+// SweepAABBs is SweepAABB for two moving boxes: a moves by deltaA and b by deltaB
+// over the same time step. Travel refers to a; Normal is the face of b.
+func SweepAABBs[T Numeric](a AABB[T], deltaA Vec[T], b AABB[T], deltaB Vec[T]) (SweepHit[T], bool) {
+	da, db := signedFloat64Vec(deltaA), signedFloat64Vec(deltaB)
+	return sweep(a, Vec[float64]{da.X - db.X, da.Y - db.Y}, deltaA, b)
+}
+
+// sweep casts moving along the relative motion rel and scales own, the moving
+// box's own delta, by the contact time.
+func sweep[T Numeric](moving AABB[T], rel Vec[float64], own Vec[T], obstacle AABB[T]) (SweepHit[T], bool) {
+	tl, br := signedFloat64Vec(moving.TopLeft), signedFloat64Vec(moving.BottomRight)
+	minV, maxV := signedFloat64Vec(obstacle.TopLeft), signedFloat64Vec(obstacle.BottomRight)
+	minV = Vec[float64]{minV.X - (br.X - tl.X), minV.Y - (br.Y - tl.Y)}
+
+	entry, exit, normal, ok := slabIntersect(tl, rel, minV, maxV, true)
+	if !ok || entry >= exit || exit <= 0 || entry > 1 {
+		return SweepHit[T]{}, false
+	}
+	if entry < 0 {
+		// pudełka nachodzą na siebie już na starcie
+		return SweepHit[T]{}, true
+	}
+	d := signedFloat64Vec(own)
+	return SweepHit[T]{
+		Time:   entry,
+		Travel: Vec[T]{truncTo[T](d.X * entry), truncTo[T](d.Y * entry)},
+		Normal: roundToVec[T](normal),
+	}, true
+}
+
+// truncTo converts f back to T rounding towards zero for integer types; eps keeps
+// float noise on exact grid values from losing a whole unit.
+func truncTo[T Numeric](f float64) T {
+	if isFloating[T]() {
+		return T(f)
+	}
+	f *= fixedScale[T]()
+	return T(int64(math.Trunc(f + math.Copysign(eps, f))))
+}
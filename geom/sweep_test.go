@@ -0,0 +1,93 @@
+package geom
+
+import "testing"
+
+func TestSweepAABB(t *testing.T) {
+	runSweepAABBTest[int](t, "int")
+	runSweepAABBTest[uint32](t, "uint32")
+	runSweepAABBTest[float64](t, "float64")
+}
+
+func runSweepAABBTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		minusOne, minusTwo, minusFour, minusTen := int32(-1), int32(-2), int32(-4), int32(-10)
+		neg := T(minusOne)
+		box := func(x, y, w, h T) AABB[T] { return NewAABBAt(v(x, y), w, h) }
+		wall := box(10, 0, 1, 20) // cienka ściana
+
+		testCases := []struct {
+			name     string
+			moving   AABB[T]
+			delta    Vec[T]
+			obstacle AABB[T]
+			hit      bool
+			time     float64
+			travel   Vec[T]
+			normal   Vec[T]
+		}{
+			{
+				name:   "tunnelsWithoutSweep",
+				moving: box(0, 5, 2, 2), delta: v(20, 0), obstacle: wall,
+				hit: true, time: 0.4, travel: v(8, 0), normal: v(neg, 0),
+			},
+			{
+				name:   "fromRight",
+				moving: box(15, 5, 2, 2), delta: v(T(minusTen), 0), obstacle: wall,
+				hit: true, time: 0.4, travel: v(T(minusFour), 0), normal: v(1, 0),
+			},
+			{
+				name:   "diagonalHitsTop",
+				moving: box(0, 0, 2, 2), delta: v(8, 8), obstacle: box(4, 6, 10, 4),
+				hit: true, time: 0.5, travel: v(4, 4), normal: v(0, neg),
+			},
+			{name: "stopsShort", moving: box(0, 5, 2, 2), delta: v(5, 0), obstacle: wall},
+			{name: "slidesAlongFace", moving: box(0, 20, 2, 2), delta: v(20, 0), obstacle: box(5, 10, 4, 10)},
+			{name: "movesAwayWhileTouching", moving: box(11, 5, 2, 2), delta: v(5, 0), obstacle: wall},
+			{
+				name:   "alreadyOverlapping",
+				moving: box(9, 5, 2, 2), delta: v(5, 0), obstacle: wall,
+				hit: true,
+			},
+			{
+				name:   "touchingAndClosing",
+				moving: box(8, 5, 2, 2), delta: v(5, 0), obstacle: wall,
+				hit: true, time: 0, travel: v(0, 0), normal: v(neg, 0),
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got, ok := SweepAABB(tc.moving, tc.delta, tc.obstacle)
+				if ok != tc.hit {
+					t.Fatalf("expected hit=%v, got %v (%+v)", tc.hit, ok, got)
+				}
+				if ok && (got.Time != tc.time || got.Travel != tc.travel || got.Normal != tc.normal) {
+					t.Errorf("expected time %v travel %v normal %v, got %+v", tc.time, tc.travel, tc.normal, got)
+				}
+			})
+		}
+
+		t.Run("bothMoving", func(t *testing.T) {
+			got, ok := SweepAABBs(box(0, 0, 2, 2), v(6, 0), box(10, 0, 2, 2), v(T(minusTwo), 0))
+			if !ok || got.Time != 1 || got.Travel != v(6, 0) || got.Normal != v(neg, 0) {
+				t.Errorf("expected contact at the end of the step, got %+v %v", got, ok)
+			}
+			if _, ok := SweepAABBs(box(0, 0, 2, 2), v(6, 0), box(10, 0, 2, 2), v(6, 0)); ok {
+				t.Errorf("boxes moving together must not collide")
+			}
+		})
+	})
+}
+
+func TestSweepAABB_IntegerTravelNeverOverlaps(t *testing.T) {
+	moving := NewAABBAt(NewVec(0, 0), 2, 2)
+	obstacle := NewAABBAt(NewVec(10, 3), 5, 5)
+	hit, ok := SweepAABB(moving, NewVec(9, 3), obstacle)
+	if !ok {
+		t.Fatalf("expected a hit")
+	}
+	moved := NewAABBAt(moving.TopLeft.Add(hit.Travel), 2, 2)
+	if moved.BottomRight.X > obstacle.TopLeft.X && moved.BottomRight.Y > obstacle.TopLeft.Y {
+		t.Errorf("travel %v pushes %v into %v", hit.Travel, moved, obstacle)
+	}
+}
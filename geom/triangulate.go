@@ -0,0 +1,194 @@
+package geom
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Triangulate splits the simple polygon outer, minus the given holes, into
+// triangles by ear clipping. Holes are first joined to the outer boundary with
+// bridge edges, so the result covers exactly the area between them.
+//
+// The returned Triangulation lists the outer vertices followed by the vertices
+// of each hole in argument order; triangle indices refer to that list and have
+// positive winding (see Polygon.SignedArea) whatever the input winding was.
+// Collinear vertices produce no zero-area triangles.
+//
+// The boolean is false when a hole cannot be bridged, which happens when it
+// lies outside outer or crosses its boundary. The triangles then cover that
+// hole as if it were not there.
+func Triangulate[T Numeric](outer Polygon[T], holes ...Polygon[T]) (Triangulation[T], bool) {
+	points := slices.Clone(outer.Vertices)
+	ring := windingRing(outer, 0, 1)
+	if len(ring) < 3 {
+		return Triangulation[T]{Points: points}, true
+	}
+
+	holeRings := make([][]int, 0, len(holes))
+	for _, hole := range holes {
+		if r := windingRing(hole, len(points), -1); len(r) >= 3 {
+			holeRings = append(holeRings, r)
+		}
+		points = append(points, hole.Vertices...)
+	}
+	// otwory najbardziej z prawej łączymy jako pierwsze, jak w earcut
+	slices.SortStableFunc(holeRings, func(a, b []int) int {
+		return compareVecXY(points[rightmostIndex(points, b)], points[rightmostIndex(points, a)])
+	})
+	ok := true
+	for i, hole := range holeRings {
+		bridged, bridgedOK := bridgeHole(points, ring, hole, holeRings[i+1:])
+		ring, ok = bridged, ok && bridgedOK
+	}
+
+	return Triangulation[T]{Points: points, Triangles: clipEars(points, ring)}, ok
+}
+
+// windingRing returns global indices of poly's vertices ordered so that the
+// sign of their signed area matches want (+1 or -1).
+func windingRing[T Numeric](poly Polygon[T], offset, want int) []int {
+	ring := make([]int, len(poly.Vertices))
+	for i := range ring {
+		ring[i] = offset + i
+	}
+	area := poly.SignedArea()
+	if (area > 0 && want < 0) || (area < 0 && want > 0) {
+		slices.Reverse(ring)
+	}
+	return ring
+}
+
+func rightmostIndex[T Numeric](points []Vec[T], ring []int) int {
+	return slices.MaxFunc(ring, func(a, b int) int { return compareVecXY(points[a], points[b]) })
+}
+
+// bridgeHole splices hole into ring through a bridge from the hole's rightmost
+// vertex to the nearest ring vertex it can see. Pending holes also block the
+// bridge. When no ring vertex is visible the ring is returned unchanged with false.
+func bridgeHole[T Numeric](points []Vec[T], ring, hole []int, pending [][]int) ([]int, bool) {
+	m := slices.Index(hole, rightmostIndex(points, hole))
+	mPos := points[hole[m]]
+
+	candidates := make([]int, 0, len(ring))
+	for k, idx := range ring {
+		if signedFloat64(points[idx].X) >= signedFloat64(mPos.X) {
+			candidates = append(candidates, k)
+		}
+	}
+	dist := func(k int) float64 {
+		return distSqFloat64(signedFloat64Vec(points[ring[k]]), signedFloat64Vec(mPos))
+	}
+	slices.SortStableFunc(candidates, func(a, b int) int { return cmp.Compare(dist(a), dist(b)) })
+
+	rings := append([][]int{ring, hole}, pending...)
+	for _, k := range candidates {
+		n := len(ring)
+		prev, p, next := points[ring[(k+n-1)%n]], points[ring[k]], points[ring[(k+1)%n]]
+		if !sectorContains(prev, p, next, mPos) || !bridgeVisible(points, rings, mPos, p) {
+			continue
+		}
+		bridged := make([]int, 0, n+len(hole)+2)
+		bridged = append(bridged, ring[:k+1]...)
+		bridged = append(bridged, hole[m:]...)
+		bridged = append(bridged, hole[:m+1]...)
+		bridged = append(bridged, ring[k:]...)
+		return bridged, true
+	}
+	// brak widocznego wierzchołka oznacza niepoprawne dane (otwór poza obrysem)
+	return ring, false
+}
+
+// sectorContains reports whether the direction p→v points into the interior
+// angle prev–p–next of a positively wound ring.
+func sectorContains[T Numeric](prev, p, next, v Vec[T]) bool {
+	if v == p {
+		return false
+	}
+	left, right := Orient2D(prev, p, v) >= 0, Orient2D(p, next, v) >= 0
+	if Orient2D(prev, p, next) >= 0 {
+		return left && right
+	}
+	return left || right
+}
+
+// bridgeVisible reports whether segment a–b crosses or touches no ring edge
+// other than at its own endpoints.
+func bridgeVisible[T Numeric](points []Vec[T], rings [][]int, a, b Vec[T]) bool {
+	for _, ring := range rings {
+		for i, idx := range ring {
+			u, v := points[idx], points[ring[(i+1)%len(ring)]]
+			if Orient2D(a, b, u)*Orient2D(a, b, v) < 0 && Orient2D(u, v, a)*Orient2D(u, v, b) < 0 {
+				return false
+			}
+			for _, w := range [2]Vec[T]{u, v} {
+				if w != a && w != b && onSegment(a, b, w) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// clipEars triangulates a positively wound, weakly simple ring of point indices.
+func clipEars[T Numeric](points []Vec[T], ring []int) [][3]int {
+	n := len(ring)
+	prev := make([]int, n)
+	next := make([]int, n)
+	for i := range n {
+		prev[i] = (i + n - 1) % n
+		next[i] = (i + 1) % n
+	}
+	remove := func(i int) {
+		next[prev[i]] = next[i]
+		prev[next[i]] = prev[i]
+	}
+
+	triangles := make([][3]int, 0, n-2)
+	remaining, cur, stall := n, 0, 0
+	for remaining > 3 {
+		p, nx := prev[cur], next[cur]
+		a, b, c := points[ring[p]], points[ring[cur]], points[ring[nx]]
+		o := Orient2D(a, b, c)
+
+		switch {
+		case o == 0:
+			// wierzchołek współliniowy lub kolec – usuwamy bez trójkąta
+		case o > 0 && (isEar(points, ring, next, p, cur, nx) || stall > remaining):
+			// stall > remaining: żadne ucho nie przeszło testu (dane zdegenerowane),
+			// wymuszamy postęp na pierwszym wypukłym wierzchołku
+			triangles = append(triangles, [3]int{ring[p], ring[cur], ring[nx]})
+		default:
+			cur = nx
+			stall++
+			if stall > 2*remaining {
+				return triangles
+			}
+			continue
+		}
+		remove(cur)
+		remaining--
+		cur, stall = nx, 0
+	}
+
+	p, nx := prev[cur], next[cur]
+	if Orient2D(points[ring[p]], points[ring[cur]], points[ring[nx]]) > 0 {
+		triangles = append(triangles, [3]int{ring[p], ring[cur], ring[nx]})
+	}
+	return triangles
+}
+
+// isEar reports whether no other ring vertex lies inside or on triangle p–cur–nx.
+func isEar[T Numeric](points []Vec[T], ring, next []int, p, cur, nx int) bool {
+	a, b, c := points[ring[p]], points[ring[cur]], points[ring[nx]]
+	for i := next[nx]; i != p; i = next[i] {
+		v := points[ring[i]]
+		if v == a || v == b || v == c {
+			continue
+		}
+		if Orient2D(a, b, v) >= 0 && Orient2D(b, c, v) >= 0 && Orient2D(c, a, v) >= 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTriangulate(t *testing.T) {
+	runTriangulateTest[int](t, "int")
+	runTriangulateTest[uint32](t, "uint32")
+	runTriangulateTest[float64](t, "float64")
+}
+
+func runTriangulateTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		v := func(x, y T) Vec[T] { return NewVec(x, y) }
+		square := func(x1, y1, x2, y2 T) Polygon[T] {
+			return NewPolygonFromAABB(NewAABB(v(x1, y1), v(x2, y2)))
+		}
+		reversed := func(p Polygon[T]) Polygon[T] {
+			vs := make([]Vec[T], len(p.Vertices))
+			for i, vertex := range p.Vertices {
+				vs[len(vs)-1-i] = vertex
+			}
+			return NewPolygon(vs...)
+		}
+
+		testCases := []struct {
+			name      string
+			outer     Polygon[T]
+			holes     []Polygon[T]
+			triangles int
+			area      float64
+		}{
+			{name: "square", outer: square(0, 0, 4, 4), triangles: 2, area: 16},
+			{name: "reversedSquare", outer: reversed(square(0, 0, 4, 4)), triangles: 2, area: 16},
+			{
+				name:      "concaveL",
+				outer:     NewPolygon(v(0, 0), v(6, 0), v(6, 2), v(2, 2), v(2, 6), v(0, 6)),
+				triangles: 4,
+				area:      20,
+			},
+			{
+				name:      "collinearEdgePoints",
+				outer:     NewPolygon(v(0, 0), v(2, 0), v(4, 0), v(4, 4), v(0, 4)),
+				triangles: 3,
+				area:      16,
+			},
+			{
+				name:      "squareHole",
+				outer:     square(0, 0, 8, 8),
+				holes:     []Polygon[T]{square(2, 2, 6, 6)},
+				triangles: 8,
+				area:      48,
+			},
+			{
+				name:      "twoHoles",
+				outer:     square(0, 0, 12, 6),
+				holes:     []Polygon[T]{square(2, 2, 4, 4), reversed(square(7, 1, 10, 4))},
+				triangles: 12,
+				area:      72 - 4 - 9,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				tr, ok := Triangulate(tc.outer, tc.holes...)
+				if !ok {
+					t.Fatalf("holes %v were not bridged", tc.holes)
+				}
+				if len(tr.Triangles) != tc.triangles {
+					t.Fatalf("got %d triangles %v, want %d", len(tr.Triangles), tr.Triangles, tc.triangles)
+				}
+				var area float64
+				for _, tri := range tr.Triangles {
+					triangle := NewPolygon(tr.Points[tri[0]], tr.Points[tri[1]], tr.Points[tri[2]])
+					if triangle.SignedArea() <= 0 {
+						t.Errorf("triangle %v is not positively wound", triangle)
+					}
+					area += triangle.SignedArea()
+					for _, hole := range tc.holes {
+						if hole.ContainsVec(triangle.Centroid()) {
+							t.Errorf("triangle %v lies inside hole %v", triangle, hole)
+						}
+					}
+				}
+				if math.Abs(area-tc.area) > eps {
+					t.Errorf("triangles cover %v, want %v", area, tc.area)
+				}
+			})
+		}
+	})
+}
+
+func TestTriangulate_IndicesFollowInputOrder(t *testing.T) {
+	outer := NewPolygonFromAABB(NewAABB(NewVec(0, 0), NewVec(8, 8)))
+	hole := NewPolygonFromAABB(NewAABB(NewVec(2, 2), NewVec(6, 6)))
+	tr, _ := Triangulate(outer, hole)
+	if len(tr.Points) != 8 {
+		t.Fatalf("expected 8 points, got %d", len(tr.Points))
+	}
+	for i, p := range hole.Vertices {
+		if tr.Points[4+i] != p {
+			t.Errorf("point %d = %v, want hole vertex %v", 4+i, tr.Points[4+i], p)
+		}
+	}
+}
+
+func TestTriangulate_UnbridgedHole(t *testing.T) {
+	runTriangulateUnbridgedHoleTest[int](t, "int")
+	runTriangulateUnbridgedHoleTest[uint32](t, "uint32")
+	runTriangulateUnbridgedHoleTest[float64](t, "float64")
+}
+
+func runTriangulateUnbridgedHoleTest[T Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		square := func(x1, y1, x2, y2 T) Polygon[T] {
+			return NewPolygonFromAABB(NewAABB(NewVec(x1, y1), NewVec(x2, y2)))
+		}
+		outer := square(0, 0, 8, 8)
+
+		testCases := []struct {
+			name  string
+			holes []Polygon[T]
+		}{
+			{name: "holeOutside", holes: []Polygon[T]{square(10, 10, 12, 12)}},
+			{name: "holeCrossesBoundary", holes: []Polygon[T]{square(6, 2, 10, 4)}},
+			{name: "oneOfTwoHolesOutside", holes: []Polygon[T]{square(2, 2, 4, 4), square(20, 2, 22, 4)}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				tr, ok := Triangulate(outer, tc.holes...)
+				if ok {
+					t.Errorf("expected holes %v to be reported as not bridged", tc.holes)
+				}
+				if len(tr.Triangles) == 0 {
+					t.Errorf("expected the outer polygon to be triangulated anyway")
+				}
+			})
+		}
+	})
+}
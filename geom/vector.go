@@ -24,11 +24,51 @@ func (v *Vec[T]) SubMutable(v2 Vec[T]) { v.X -= v2.X; v.Y -= v2.Y }
 // Invert directions
 func (v *Vec[T]) Invert() { v.X = -v.X; v.Y = -v.Y }
 
-// Multiply by factor
-func (v *Vec[T]) Multiply(factor T) { v.X = v.X * factor; v.Y = v.Y * factor }
+// Multiply by factor; Fixed components are multiplied with Fixed.Mul.
+func (v *Vec[T]) Multiply(factor T) { v.X = mulTo(v.X, factor); v.Y = mulTo(v.Y, factor) }
 
 // Equals reports whether v and v2 have the same components.
 func (v Vec[T]) Equals(v2 Vec[T]) bool { return v.X == v2.X && v.Y == v2.Y }
 
+// Dot returns the scalar product of v and v2.
+func (v Vec[T]) Dot(v2 Vec[T]) T { return VectorMathByType[T]().Dot(v, v2) }
+
+// Cross returns the 2D perp-dot product of v and v2 (the z component of their 3D cross product).
+func (v Vec[T]) Cross(v2 Vec[T]) T { return VectorMathByType[T]().Cross(v, v2) }
+
+// DistanceSquared returns the squared Euclidean distance between v and v2.
+func (v Vec[T]) DistanceSquared(v2 Vec[T]) T { return VectorMathByType[T]().DistanceSquared(v, v2) }
+
+// Normalize returns v scaled to unit length, rounded for integer components.
+func (v Vec[T]) Normalize() Vec[T] { return VectorMathByType[T]().Normalize(v) }
+
+// Lerp returns the point at parameter t on the line from v (t=0) to v2 (t=1).
+func (v Vec[T]) Lerp(v2 Vec[T], t float64) Vec[T] { return VectorMathByType[T]().Lerp(v, v2, t) }
+
+// Rotate returns v turned around the origin by angle radians.
+func (v Vec[T]) Rotate(angle float64) Vec[T] { return VectorMathByType[T]().Rotate(v, angle) }
+
+// Project returns the orthogonal projection of v onto axis.
+func (v Vec[T]) Project(axis Vec[T]) Vec[T] { return VectorMathByType[T]().Project(v, axis) }
+
+// Reflect mirrors v against the line perpendicular to normal.
+func (v Vec[T]) Reflect(normal Vec[T]) Vec[T] { return VectorMathByType[T]().Reflect(v, normal) }
+
+// Angle returns the signed angle in radians from v to v2.
+func (v Vec[T]) Angle(v2 Vec[T]) float64 { return VectorMathByType[T]().Angle(v, v2) }
+
+// CheckedAdd returns v+v2 and false when the result cannot be represented;
+// see VectorMath.CheckedAdd for the per-type rules.
+func (v Vec[T]) CheckedAdd(v2 Vec[T]) (Vec[T], bool) { return VectorMathByType[T]().CheckedAdd(v, v2) }
+
+// CheckedSub returns v-v2 and false when the result cannot be represented.
+func (v Vec[T]) CheckedSub(v2 Vec[T]) (Vec[T], bool) { return VectorMathByType[T]().CheckedSub(v, v2) }
+
+// SaturatingAdd returns v+v2 with overflowing components pinned to the nearest bound.
+func (v Vec[T]) SaturatingAdd(v2 Vec[T]) Vec[T] { return VectorMathByType[T]().SaturatingAdd(v, v2) }
+
+// SaturatingSub returns v-v2 with overflowing components pinned to the nearest bound.
+func (v Vec[T]) SaturatingSub(v2 Vec[T]) Vec[T] { return VectorMathByType[T]().SaturatingSub(v, v2) }
+
 // String formats v as "(X,Y)".
 func (v Vec[T]) String() string { return fmt.Sprintf("(%v,%v)", v.X, v.Y) }
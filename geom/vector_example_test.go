@@ -2,6 +2,7 @@ package geom
 
 import (
 	"fmt"
+	"math"
 )
 
 // ExampleVec_Add demonstrates how to use the Add method.
@@ -58,3 +59,21 @@ func ExampleVec_String() {
 	fmt.Println(v.String())
 	// Output: (1,2)
 }
+
+// ExampleVec_Dot demonstrates how to use the Dot and Cross methods.
+func ExampleVec_Dot() {
+	v1 := NewVec(2, 3)
+	v2 := NewVec(4, 1)
+	fmt.Println(v1.Dot(v2))
+	fmt.Println(v1.Cross(v2))
+	// Output:
+	// 11
+	// -10
+}
+
+// ExampleVec_Rotate demonstrates how integer vectors are rounded after rotation.
+func ExampleVec_Rotate() {
+	v := NewVec(10, 0)
+	fmt.Println(v.Rotate(math.Pi / 4))
+	// Output: (7,7)
+}
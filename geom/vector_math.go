@@ -4,14 +4,19 @@ package geom
 import (
 	"fmt"
 	"math"
+	"math/bits"
 )
 
 // VectorMath exposes vector operations for numeric components.
 type (
-	SignedInt   interface{ ~int | ~int64 | ~int32 }
-	UnsignedInt interface{ ~uint32 }
-	Floating    interface{ ~float32 | ~float64 }
-	Numeric     interface {
+	SignedInt interface {
+		~int | ~int64 | ~int32 | ~int16
+	}
+	UnsignedInt interface {
+		~uint64 | ~uint32 | ~uint16 | ~uint8
+	}
+	Floating interface{ ~float32 | ~float64 }
+	Numeric  interface {
 		SignedInt | UnsignedInt | Floating
 	}
 
@@ -29,6 +34,39 @@ type (
 		// Wrap folds v1 back into [0,size) using modulo semantics appropriate for T and returns the wrapped vector.
 		Wrap(v1 Vec[T], size Vec[T]) Vec[T]
 		Sub(v1, v2 Vec[T]) Vec[T]
+		// Dot returns the scalar product v1.X*v2.X + v1.Y*v2.Y.
+		Dot(v1, v2 Vec[T]) T
+		// Cross returns the 2D perp-dot product v1.X*v2.Y - v1.Y*v2.X.
+		Cross(v1, v2 Vec[T]) T
+		// DistanceSquared returns the squared Euclidean distance between v1 and v2.
+		DistanceSquared(v1, v2 Vec[T]) T
+		// Normalize scales v to unit length; the zero vector is returned unchanged.
+		Normalize(v Vec[T]) Vec[T]
+		// Lerp interpolates linearly from v1 (t=0) to v2 (t=1).
+		Lerp(v1, v2 Vec[T], t float64) Vec[T]
+		// Rotate turns v around the origin by angle radians (from +X towards +Y).
+		Rotate(v Vec[T], angle float64) Vec[T]
+		// Project returns the orthogonal projection of v onto axis.
+		Project(v, axis Vec[T]) Vec[T]
+		// Reflect mirrors v against the line perpendicular to normal.
+		Reflect(v, normal Vec[T]) Vec[T]
+		// Angle returns the signed angle in radians from v1 to v2, in [-π, π].
+		Angle(v1, v2 Vec[T]) float64
+		// CheckedAdd returns v1+v2 and false when a component leaves the range of T.
+		// Unsigned types use the signed range of their width, see
+		// UnsignedIntVectorMath.
+		CheckedAdd(v1, v2 Vec[T]) (Vec[T], bool)
+		// CheckedSub returns v1-v2 and false when a component leaves the range of T.
+		// Unsigned types use the signed range of their width.
+		CheckedSub(v1, v2 Vec[T]) (Vec[T], bool)
+		// SaturatingAdd returns v1+v2 with each component pinned to the range of T.
+		// Unsigned types are pinned to the signed range of their width, so a
+		// negative result keeps its two's complement form instead of stopping at 0.
+		SaturatingAdd(v1, v2 Vec[T]) Vec[T]
+		// SaturatingSub returns v1-v2 with each component pinned to the range of T,
+		// or to the signed range of the width for unsigned types: for uint32,
+		// 3-5 is 0xFFFF_FFFE (-2), not 0.
+		SaturatingSub(v1, v2 Vec[T]) Vec[T]
 	}
 )
 
@@ -40,7 +78,12 @@ var (
 	intVecMath     = SignedIntVectorMath[int]{}
 	int32VecMath   = SignedIntVectorMath[int32]{}
 	int64VecMath   = SignedIntVectorMath[int64]{}
+	int16VecMath   = SignedIntVectorMath[int16]{}
+	uint64VecMath  = UnsignedIntVectorMath[uint64]{}
 	uint32VecMath  = UnsignedIntVectorMath[uint32]{}
+	uint16VecMath  = UnsignedIntVectorMath[uint16]{}
+	uint8VecMath   = UnsignedIntVectorMath[uint8]{}
+	fixedVecMath   = FixedVectorMath{}
 )
 
 func VectorMathByType[T Numeric]() VectorMath[T] {
@@ -57,8 +100,18 @@ func VectorMathByType[T Numeric]() VectorMath[T] {
 		return any(int64VecMath).(VectorMath[T])
 	case int32:
 		return any(int32VecMath).(VectorMath[T])
+	case int16:
+		return any(int16VecMath).(VectorMath[T])
+	case uint64:
+		return any(uint64VecMath).(VectorMath[T])
 	case uint32:
 		return any(uint32VecMath).(VectorMath[T])
+	case uint16:
+		return any(uint16VecMath).(VectorMath[T])
+	case uint8:
+		return any(uint8VecMath).(VectorMath[T])
+	case Fixed:
+		return any(fixedVecMath).(VectorMath[T])
 	default:
 		panic(fmt.Sprintf("no VectorMath implementation for %T", zero))
 	}
@@ -91,6 +144,60 @@ func (m FloatVectorMath[T]) Sub(v1, v2 Vec[T]) Vec[T] {
 	return v1.Sub(v2)
 }
 
+func (m FloatVectorMath[T]) Dot(v1, v2 Vec[T]) T {
+	return T(dotFloat64(toFloat64Vec(v1), toFloat64Vec(v2)))
+}
+
+func (m FloatVectorMath[T]) Cross(v1, v2 Vec[T]) T {
+	return T(crossFloat64(toFloat64Vec(v1), toFloat64Vec(v2)))
+}
+
+func (m FloatVectorMath[T]) DistanceSquared(v1, v2 Vec[T]) T {
+	d := toFloat64Vec(v1).Sub(toFloat64Vec(v2))
+	return T(dotFloat64(d, d))
+}
+
+func (m FloatVectorMath[T]) Normalize(v Vec[T]) Vec[T] {
+	return fromFloat64Vec[T](normalizeFloat64(toFloat64Vec(v)))
+}
+
+func (m FloatVectorMath[T]) Lerp(v1, v2 Vec[T], t float64) Vec[T] {
+	return fromFloat64Vec[T](lerpFloat64(toFloat64Vec(v1), toFloat64Vec(v2), t))
+}
+
+func (m FloatVectorMath[T]) Rotate(v Vec[T], angle float64) Vec[T] {
+	return fromFloat64Vec[T](rotateFloat64(toFloat64Vec(v), angle))
+}
+
+func (m FloatVectorMath[T]) Project(v, axis Vec[T]) Vec[T] {
+	return fromFloat64Vec[T](projectFloat64(toFloat64Vec(v), toFloat64Vec(axis)))
+}
+
+func (m FloatVectorMath[T]) Reflect(v, normal Vec[T]) Vec[T] {
+	return fromFloat64Vec[T](reflectFloat64(toFloat64Vec(v), toFloat64Vec(normal)))
+}
+
+func (m FloatVectorMath[T]) Angle(v1, v2 Vec[T]) float64 {
+	return angleFloat64(toFloat64Vec(v1), toFloat64Vec(v2))
+}
+
+// CheckedAdd and CheckedSub report false when a component overflows to ±Inf.
+func (m FloatVectorMath[T]) CheckedAdd(v1, v2 Vec[T]) (Vec[T], bool) {
+	r := v1.Add(v2)
+	return r, isFiniteVec(r)
+}
+
+func (m FloatVectorMath[T]) CheckedSub(v1, v2 Vec[T]) (Vec[T], bool) {
+	r := v1.Sub(v2)
+	return r, isFiniteVec(r)
+}
+
+// SaturatingAdd and SaturatingSub are plain IEEE arithmetic, which already
+// saturates to ±Inf.
+func (m FloatVectorMath[T]) SaturatingAdd(v1, v2 Vec[T]) Vec[T] { return v1.Add(v2) }
+
+func (m FloatVectorMath[T]) SaturatingSub(v1, v2 Vec[T]) Vec[T] { return v1.Sub(v2) }
+
 // -----------------------------------------------------------------------------
 
 type SignedIntVectorMath[T SignedInt] struct{}
@@ -116,8 +223,76 @@ func (m SignedIntVectorMath[T]) Wrap(v Vec[T], size Vec[T]) Vec[T] {
 
 func (m SignedIntVectorMath[T]) Sub(v1, v2 Vec[T]) Vec[T] { return v1.Sub(v2) }
 
+// Dot, Cross and DistanceSquared are exact whenever the result fits in T and
+// saturate to the range of T otherwise; products are taken in 128 bits, so
+// int64 inputs cannot overflow on the way.
+func (m SignedIntVectorMath[T]) Dot(v1, v2 Vec[T]) T {
+	return pinSigned[T](dotPinned(toInt64Vec(v1), toInt64Vec(v2)))
+}
+
+func (m SignedIntVectorMath[T]) Cross(v1, v2 Vec[T]) T {
+	return pinSigned[T](crossPinned(toInt64Vec(v1), toInt64Vec(v2)))
+}
+
+func (m SignedIntVectorMath[T]) DistanceSquared(v1, v2 Vec[T]) T {
+	return pinSigned[T](distanceSquaredPinned(toInt64Vec(v1), toInt64Vec(v2)))
+}
+
+// Normalize rounds the unit vector to the nearest integer components, so the
+// result is one of the eight king-move directions or the zero vector.
+func (m SignedIntVectorMath[T]) Normalize(v Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](normalizeFloat64(toFloat64Vec(v)))
+}
+
+// Lerp, Rotate, Project and Reflect compute in float64 and round half away from zero.
+func (m SignedIntVectorMath[T]) Lerp(v1, v2 Vec[T], t float64) Vec[T] {
+	return roundFloat64Vec[T](lerpFloat64(toFloat64Vec(v1), toFloat64Vec(v2), t))
+}
+
+func (m SignedIntVectorMath[T]) Rotate(v Vec[T], angle float64) Vec[T] {
+	return roundFloat64Vec[T](rotateFloat64(toFloat64Vec(v), angle))
+}
+
+func (m SignedIntVectorMath[T]) Project(v, axis Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](projectFloat64(toFloat64Vec(v), toFloat64Vec(axis)))
+}
+
+func (m SignedIntVectorMath[T]) Reflect(v, normal Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](reflectFloat64(toFloat64Vec(v), toFloat64Vec(normal)))
+}
+
+func (m SignedIntVectorMath[T]) Angle(v1, v2 Vec[T]) float64 {
+	return angleFloat64(toFloat64Vec(v1), toFloat64Vec(v2))
+}
+
+func (m SignedIntVectorMath[T]) CheckedAdd(v1, v2 Vec[T]) (Vec[T], bool) {
+	x, okX := checkedAddSigned(v1.X, v2.X)
+	y, okY := checkedAddSigned(v1.Y, v2.Y)
+	return Vec[T]{x, y}, okX && okY
+}
+
+func (m SignedIntVectorMath[T]) CheckedSub(v1, v2 Vec[T]) (Vec[T], bool) {
+	x, okX := checkedSubSigned(v1.X, v2.X)
+	y, okY := checkedSubSigned(v1.Y, v2.Y)
+	return Vec[T]{x, y}, okX && okY
+}
+
+func (m SignedIntVectorMath[T]) SaturatingAdd(v1, v2 Vec[T]) Vec[T] {
+	return Vec[T]{saturatingAddSigned(v1.X, v2.X), saturatingAddSigned(v1.Y, v2.Y)}
+}
+
+func (m SignedIntVectorMath[T]) SaturatingSub(v1, v2 Vec[T]) Vec[T] {
+	return Vec[T]{saturatingSubSigned(v1.X, v2.X), saturatingSubSigned(v1.Y, v2.Y)}
+}
+
 //-----------------------------------------------------------------------------
 
+// UnsignedIntVectorMath reads components as the two's complement signed integer
+// of the same width (int32 for uint32, int8 for uint8, ...), so a delta such as
+// 0xFFFF_FFFF means -1 and Clamp and Wrap can bring it back into range. Sizes
+// are expected to fit in that signed range; a box at the far edge of a space
+// also needs its own size plus its step of headroom, so 127 for uint8 or 32767
+// for uint16 is only safe for points.
 type UnsignedIntVectorMath[T UnsignedInt] struct{}
 
 func (m UnsignedIntVectorMath[T]) Length(v Vec[T]) T {
@@ -126,10 +301,10 @@ func (m UnsignedIntVectorMath[T]) Length(v Vec[T]) T {
 }
 
 func (m UnsignedIntVectorMath[T]) Clamp(v Vec[T], size Vec[T]) Vec[T] {
-	// odczytaj komponenty jako signed (int32 -> int64),
+	// odczytaj komponenty jako signed tej samej szerokości,
 	// żeby np. 0xFFFF_FFF8 traktować jako -8, a nie 4_294_967_288
-	sx := int64(int32(v.X))
-	sy := int64(int32(v.Y))
+	sx := signedInt64(v.X)
+	sy := signedInt64(v.Y)
 
 	maxX := int64(size.X)
 	maxY := int64(size.Y)
@@ -141,11 +316,8 @@ func (m UnsignedIntVectorMath[T]) Clamp(v Vec[T], size Vec[T]) Vec[T] {
 }
 
 func (m UnsignedIntVectorMath[T]) Wrap(v Vec[T], size Vec[T]) Vec[T] {
-	// reinterpretacja do signed (int32 -> int64)
-	signed := Vec[int64]{
-		int64(int32(v.X)),
-		int64(int32(v.Y)),
-	}
+	// reinterpretacja do signed tej samej szerokości
+	signed := reinterpretInt64Vec(v)
 	bounds := Vec[int64]{
 		int64(size.X),
 		int64(size.Y),
@@ -163,6 +335,81 @@ func (m UnsignedIntVectorMath[T]) Sub(v1, v2 Vec[T]) Vec[T] {
 	return NewVec(T(sx), T(sy))
 }
 
+// Dot, Cross and DistanceSquared read components as signed (like Clamp and Wrap)
+// and saturate to the signed range of the width, so negative results keep their
+// two's complement form and overflowing ones stop at the ends of that range.
+func (m UnsignedIntVectorMath[T]) Dot(v1, v2 Vec[T]) T {
+	return saturateSigned[T](dotPinned(reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)))
+}
+
+func (m UnsignedIntVectorMath[T]) Cross(v1, v2 Vec[T]) T {
+	return saturateSigned[T](crossPinned(reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)))
+}
+
+func (m UnsignedIntVectorMath[T]) DistanceSquared(v1, v2 Vec[T]) T {
+	return saturateSigned[T](distanceSquaredPinned(reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)))
+}
+
+func (m UnsignedIntVectorMath[T]) Normalize(v Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](normalizeFloat64(reinterpretFloat64Vec(v)))
+}
+
+func (m UnsignedIntVectorMath[T]) Lerp(v1, v2 Vec[T], t float64) Vec[T] {
+	return roundFloat64Vec[T](lerpFloat64(reinterpretFloat64Vec(v1), reinterpretFloat64Vec(v2), t))
+}
+
+func (m UnsignedIntVectorMath[T]) Rotate(v Vec[T], angle float64) Vec[T] {
+	return roundFloat64Vec[T](rotateFloat64(reinterpretFloat64Vec(v), angle))
+}
+
+func (m UnsignedIntVectorMath[T]) Project(v, axis Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](projectFloat64(reinterpretFloat64Vec(v), reinterpretFloat64Vec(axis)))
+}
+
+func (m UnsignedIntVectorMath[T]) Reflect(v, normal Vec[T]) Vec[T] {
+	return roundFloat64Vec[T](reflectFloat64(reinterpretFloat64Vec(v), reinterpretFloat64Vec(normal)))
+}
+
+func (m UnsignedIntVectorMath[T]) Angle(v1, v2 Vec[T]) float64 {
+	return angleFloat64(reinterpretFloat64Vec(v1), reinterpretFloat64Vec(v2))
+}
+
+// CheckedAdd and CheckedSub read components as signed, like Clamp and Wrap, so a
+// two's complement delta such as 0xFFFFFFFF (-1 for uint32) is a valid step,
+// while a result past the signed range of the width (2^31-1 or -2^31 for uint32)
+// – which Clamp and Wrap would misread – is reported.
+func (m UnsignedIntVectorMath[T]) CheckedAdd(v1, v2 Vec[T]) (Vec[T], bool) {
+	a, b := reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)
+	x, okX := checkedAddSigned(a.X, b.X)
+	y, okY := checkedAddSigned(a.Y, b.Y)
+	return checkedSignedResult[T](x, y, okX && okY)
+}
+
+func (m UnsignedIntVectorMath[T]) CheckedSub(v1, v2 Vec[T]) (Vec[T], bool) {
+	a, b := reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)
+	x, okX := checkedSubSigned(a.X, b.X)
+	y, okY := checkedSubSigned(a.Y, b.Y)
+	return checkedSignedResult[T](x, y, okX && okY)
+}
+
+// SaturatingAdd and SaturatingSub pin each component to the signed range of the
+// width, so the result stays meaningful for Clamp and Wrap.
+func (m UnsignedIntVectorMath[T]) SaturatingAdd(v1, v2 Vec[T]) Vec[T] {
+	a, b := reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)
+	return Vec[T]{
+		saturateSigned[T](saturatingAddSigned(a.X, b.X)),
+		saturateSigned[T](saturatingAddSigned(a.Y, b.Y)),
+	}
+}
+
+func (m UnsignedIntVectorMath[T]) SaturatingSub(v1, v2 Vec[T]) Vec[T] {
+	a, b := reinterpretInt64Vec(v1), reinterpretInt64Vec(v2)
+	return Vec[T]{
+		saturateSigned[T](saturatingSubSigned(a.X, b.X)),
+		saturateSigned[T](saturatingSubSigned(a.Y, b.Y)),
+	}
+}
+
 //-----------------------------------------------------------------------------
 
 func clampSigned[T SignedInt | Floating](val, max T) T {
@@ -244,3 +491,285 @@ func lengthFloat64XY[T Numeric](x, y T) float64 {
 	dy := float64(y)
 	return math.Sqrt(dx*dx + dy*dy)
 }
+
+// -----------------------------------------------------------------------------
+
+func toFloat64Vec[T Numeric](v Vec[T]) Vec[float64] {
+	return Vec[float64]{float64(v.X), float64(v.Y)}
+}
+
+func fromFloat64Vec[T Floating](v Vec[float64]) Vec[T] {
+	return Vec[T]{T(v.X), T(v.Y)}
+}
+
+// roundFloat64Vec converts through int64 so negative results wrap into uint32
+// the same way Clamp and Wrap reinterpret them.
+func roundFloat64Vec[T SignedInt | UnsignedInt](v Vec[float64]) Vec[T] {
+	return Vec[T]{T(int64(math.Round(v.X))), T(int64(math.Round(v.Y)))}
+}
+
+func toInt64Vec[T SignedInt](v Vec[T]) Vec[int64] {
+	return Vec[int64]{int64(v.X), int64(v.Y)}
+}
+
+func reinterpretInt64Vec[T UnsignedInt](v Vec[T]) Vec[int64] {
+	return Vec[int64]{signedInt64(v.X), signedInt64(v.Y)}
+}
+
+func reinterpretFloat64Vec[T UnsignedInt](v Vec[T]) Vec[float64] {
+	return Vec[float64]{float64(signedInt64(v.X)), float64(signedInt64(v.Y))}
+}
+
+func dotInt64(v1, v2 Vec[int64]) int64 { return v1.X*v2.X + v1.Y*v2.Y }
+
+func crossInt64(v1, v2 Vec[int64]) int64 { return v1.X*v2.Y - v1.Y*v2.X }
+
+// dotPinned, crossPinned and distanceSquaredPinned return the exact result
+// pinned to the int64 range.
+func dotPinned(v1, v2 Vec[int64]) int64 {
+	return sumPinned(mul64(v1.X, v2.X), mul64(v1.Y, v2.Y))
+}
+
+func crossPinned(v1, v2 Vec[int64]) int64 {
+	return sumPinned(mul64(v1.X, v2.Y), mul64(v1.Y, v2.X).neg())
+}
+
+func distanceSquaredPinned(v1, v2 Vec[int64]) int64 {
+	dx, okX := sub64(v1.X, v2.X)
+	dy, okY := sub64(v1.Y, v2.Y)
+	if !okX || !okY {
+		// różnica poza int64 daje kwadrat co najmniej 2^126
+		return math.MaxInt64
+	}
+	return sumPinned(mul64(dx, dx), mul64(dy, dy))
+}
+
+// sumPinned adds two products from mul64 and pins the sum to the int64 range.
+// The 128-bit sum itself only overflows for (-2^63)² + (-2^63)², which has
+// both inputs positive and is caught by its sign.
+func sumPinned(p, q int128) int64 {
+	sum := p.add(q)
+	switch {
+	case p.hi >= 0 && q.hi >= 0 && sum.hi < 0:
+		return math.MaxInt64
+	case sum.hi > 0 || (sum.hi == 0 && sum.lo > math.MaxInt64):
+		return math.MaxInt64
+	case sum.hi < -1 || (sum.hi == -1 && sum.lo < 1<<63):
+		return math.MinInt64
+	}
+	return int64(sum.lo)
+}
+
+func dotFloat64(v1, v2 Vec[float64]) float64 { return v1.X*v2.X + v1.Y*v2.Y }
+
+func crossFloat64(v1, v2 Vec[float64]) float64 { return v1.X*v2.Y - v1.Y*v2.X }
+
+func normalizeFloat64(v Vec[float64]) Vec[float64] {
+	l := math.Hypot(v.X, v.Y)
+	if l == 0 {
+		return v
+	}
+	return Vec[float64]{v.X / l, v.Y / l}
+}
+
+func lerpFloat64(v1, v2 Vec[float64], t float64) Vec[float64] {
+	return Vec[float64]{
+		X: v1.X + (v2.X-v1.X)*t,
+		Y: v1.Y + (v2.Y-v1.Y)*t,
+	}
+}
+
+func rotateFloat64(v Vec[float64], angle float64) Vec[float64] {
+	sin, cos := math.Sincos(angle)
+	return Vec[float64]{
+		X: v.X*cos - v.Y*sin,
+		Y: v.X*sin + v.Y*cos,
+	}
+}
+
+func projectFloat64(v, axis Vec[float64]) Vec[float64] {
+	lenSq := dotFloat64(axis, axis)
+	if lenSq == 0 {
+		return Vec[float64]{}
+	}
+	k := dotFloat64(v, axis) / lenSq
+	return Vec[float64]{axis.X * k, axis.Y * k}
+}
+
+func reflectFloat64(v, normal Vec[float64]) Vec[float64] {
+	p := projectFloat64(v, normal)
+	return Vec[float64]{v.X - 2*p.X, v.Y - 2*p.Y}
+}
+
+func angleFloat64(v1, v2 Vec[float64]) float64 {
+	return math.Atan2(crossFloat64(v1, v2), dotFloat64(v1, v2))
+}
+
+// -----------------------------------------------------------------------------
+
+func isFiniteVec[T Floating](v Vec[T]) bool {
+	return !math.IsInf(float64(v.X), 0) && !math.IsInf(float64(v.Y), 0) &&
+		!math.IsNaN(float64(v.X)) && !math.IsNaN(float64(v.Y))
+}
+
+func checkedAddSigned[T SignedInt](a, b T) (T, bool) {
+	r := a + b
+	return r, (b >= 0) == (r >= a)
+}
+
+func checkedSubSigned[T SignedInt](a, b T) (T, bool) {
+	r := a - b
+	return r, (b >= 0) == (r <= a)
+}
+
+func saturatingAddSigned[T SignedInt](a, b T) T {
+	if r, ok := checkedAddSigned(a, b); ok {
+		return r
+	}
+	if b > 0 {
+		return maxSigned[T]()
+	}
+	return minSigned[T]()
+}
+
+func saturatingSubSigned[T SignedInt](a, b T) T {
+	if r, ok := checkedSubSigned(a, b); ok {
+		return r
+	}
+	if b < 0 {
+		return maxSigned[T]()
+	}
+	return minSigned[T]()
+}
+
+// maxSigned returns the largest value of T whatever its width.
+func maxSigned[T SignedInt]() T {
+	m := T(1)
+	for m<<1 > 0 {
+		m <<= 1
+	}
+	return m | (m - 1)
+}
+
+func minSigned[T SignedInt]() T { return -maxSigned[T]() - 1 }
+
+func checkedSignedResult[T UnsignedInt](x, y int64, ok bool) (Vec[T], bool) {
+	r := Vec[T]{T(x), T(y)}
+	return r, ok && signedInt64(r.X) == x && signedInt64(r.Y) == y
+}
+
+// pinSigned pins v to the range of T.
+func pinSigned[T SignedInt](v int64) T {
+	return T(min(max(v, int64(minSigned[T]())), int64(maxSigned[T]())))
+}
+
+// saturateSigned pins v to the signed range of T's width.
+func saturateSigned[T UnsignedInt](v int64) T {
+	hi := int64(^T(0) >> 1)
+	return T(min(max(v, -hi-1), hi))
+}
+
+// -----------------------------------------------------------------------------
+// Generic conversions used by shape code that computes in float64 regardless of T.
+
+func isUnsigned[T Numeric]() bool {
+	var zero T
+	return zero-1 > zero
+}
+
+func isFloating[T Numeric]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// fixedScale returns how many raw units of T make up 1: FixedOne for Fixed and
+// 1 for every other type, so float64 results such as areas use real units.
+func fixedScale[T Numeric]() float64 {
+	var zero T
+	if _, ok := any(zero).(Fixed); ok {
+		return float64(FixedOne)
+	}
+	return 1
+}
+
+// mulTo returns a*b in T. Fixed matches SignedInt through its int32, so its raw
+// product is rescaled with Fixed.Mul instead of being taken as an integer.
+func mulTo[T Numeric](a, b T) T {
+	if f, ok := any(a).(Fixed); ok {
+		return T(f.Mul(Fixed(b)))
+	}
+	return a * b
+}
+
+// signedLess reports whether a < b, reading unsigned components as signed like
+// signedInt64.
+func signedLess[T Numeric](a, b T) bool {
+	if isUnsigned[T]() {
+		return signedInt64(a) < signedInt64(b)
+	}
+	return a < b
+}
+
+// lowerSigned returns the smaller of a and b, reading unsigned components as
+// signed like signedInt64.
+func lowerSigned[T Numeric](a, b T) T {
+	if signedLess(b, a) {
+		return b
+	}
+	return a
+}
+
+// upperSigned returns the larger of a and b, reading unsigned components as
+// signed like signedInt64.
+func upperSigned[T Numeric](a, b T) T {
+	if signedLess(a, b) {
+		return b
+	}
+	return a
+}
+
+// signedInt64 reads an unsigned x as the signed integer of the same width, the
+// way UnsignedIntVectorMath.Clamp and Wrap do; other types convert directly.
+func signedInt64[T Numeric](x T) int64 {
+	if !isUnsigned[T]() {
+		return int64(x)
+	}
+	var zero T
+	shift := 64 - bits.Len64(uint64(zero-1))
+	return int64(uint64(x)<<shift) >> shift
+}
+
+// signedFloat64 converts x to float64, reading unsigned components as signed the
+// same way UnsignedIntVectorMath.Clamp and Wrap do.
+func signedFloat64[T Numeric](x T) float64 {
+	if isUnsigned[T]() {
+		return float64(signedInt64(x))
+	}
+	return float64(x) / fixedScale[T]()
+}
+
+func signedFloat64Vec[T Numeric](v Vec[T]) Vec[float64] {
+	return Vec[float64]{signedFloat64(v.X), signedFloat64(v.Y)}
+}
+
+// roundTo converts f back to T, rounding half away from zero for integer types.
+func roundTo[T Numeric](f float64) T {
+	if isFloating[T]() {
+		return T(f)
+	}
+	return T(int64(math.Round(f * fixedScale[T]())))
+}
+
+func roundToVec[T Numeric](v Vec[float64]) Vec[T] {
+	return Vec[T]{roundTo[T](v.X), roundTo[T](v.Y)}
+}
+
+// lengthTo converts a non-negative length back to T, rounding integer results up
+// like VectorMath.Length does. The eps margin keeps float noise from bumping
+// exact integer distances to the next value.
+func lengthTo[T Numeric](f float64) T {
+	if isFloating[T]() {
+		return T(f)
+	}
+	return T(int64(math.Ceil(f*fixedScale[T]() - eps)))
+}
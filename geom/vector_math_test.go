@@ -1,12 +1,17 @@
 package geom
 
 import (
+	stdmath "math"
 	"testing"
 )
 
 func TestVectorMath_Length(t *testing.T) {
 	runLengthTest(t, "int", SignedIntVectorMath[int]{})
 	runLengthTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runLengthTest(t, "int16", SignedIntVectorMath[int16]{})
+	runLengthTest(t, "uint8", UnsignedIntVectorMath[uint8]{})
+	runLengthTest(t, "uint16", UnsignedIntVectorMath[uint16]{})
+	runLengthTest(t, "uint64", UnsignedIntVectorMath[uint64]{})
 	runLengthTest(t, "float64", FloatVectorMath[float64]{})
 }
 
@@ -24,6 +29,10 @@ func runLengthTest[T Numeric](t *testing.T, name string, math VectorMath[T]) {
 func TestVectorMath_Clamp(t *testing.T) {
 	runClampTest(t, "int", SignedIntVectorMath[int]{})
 	runClampTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runClampTest(t, "int16", SignedIntVectorMath[int16]{})
+	runClampTest(t, "uint8", UnsignedIntVectorMath[uint8]{})
+	runClampTest(t, "uint16", UnsignedIntVectorMath[uint16]{})
+	runClampTest(t, "uint64", UnsignedIntVectorMath[uint64]{})
 	runClampTest(t, "float64", FloatVectorMath[float64]{})
 }
 
@@ -53,6 +62,10 @@ func runClampTest[T Numeric](t *testing.T, name string, math VectorMath[T]) {
 func TestVectorMath_Wrap(t *testing.T) {
 	runWrapTest(t, "int", SignedIntVectorMath[int]{})
 	runWrapTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runWrapTest(t, "int16", SignedIntVectorMath[int16]{})
+	runWrapTest(t, "uint8", UnsignedIntVectorMath[uint8]{})
+	runWrapTest(t, "uint16", UnsignedIntVectorMath[uint16]{})
+	runWrapTest(t, "uint64", UnsignedIntVectorMath[uint64]{})
 	runWrapTest(t, "float64", FloatVectorMath[float64]{})
 }
 
@@ -88,3 +101,252 @@ func runWrapTest[T Numeric](t *testing.T, name string, math VectorMath[T]) {
 		}
 	})
 }
+
+func TestVectorMath_DotCross(t *testing.T) {
+	runDotCrossTest(t, "int", SignedIntVectorMath[int]{})
+	runDotCrossTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runDotCrossTest(t, "float64", FloatVectorMath[float64]{})
+}
+
+func runDotCrossTest[T Numeric](t *testing.T, name string, math VectorMath[T]) {
+	t.Run(name, func(t *testing.T) {
+		a := NewVec(T(2), T(3))
+		b := NewVec(T(4), T(1))
+
+		if got := math.Dot(a, b); got != T(11) {
+			t.Errorf("dot: expected 11, got %v", got)
+		}
+		if got := math.Cross(b, a); got != T(10) {
+			t.Errorf("cross: expected 10, got %v", got)
+		}
+		if got := math.DistanceSquared(a, b); got != T(8) {
+			t.Errorf("distanceSquared: expected 8, got %v", got)
+		}
+		// odwrotna kolejność daje ujemny iloczyn, także w reprezentacji uint32
+		minusTen := int32(-10)
+		if got := math.Cross(a, b); got != T(minusTen) {
+			t.Errorf("cross: expected -10, got %v", got)
+		}
+	})
+}
+
+func TestVectorMath_Transformations(t *testing.T) {
+	runTransformationsTest(t, "int", SignedIntVectorMath[int]{})
+	runTransformationsTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runTransformationsTest(t, "float64", FloatVectorMath[float64]{})
+}
+
+func runTransformationsTest[T Numeric](t *testing.T, name string, vm VectorMath[T]) {
+	t.Run(name, func(t *testing.T) {
+		minusTwo := int32(-2)
+		minusFive := int32(-5)
+		cases := []struct {
+			name     string
+			got      Vec[T]
+			expected Vec[T]
+		}{
+			{"normalize", vm.Normalize(NewVec(T(0), T(7))), NewVec(T(0), T(1))},
+			{"normalizeZero", vm.Normalize(Vec[T]{}), Vec[T]{}},
+			{"lerpMid", vm.Lerp(NewVec(T(2), T(4)), NewVec(T(6), T(8)), 0.5), NewVec(T(4), T(6))},
+			{"lerpEnd", vm.Lerp(NewVec(T(2), T(4)), NewVec(T(6), T(8)), 1), NewVec(T(6), T(8))},
+			{"rotateQuarter", vm.Rotate(NewVec(T(4), T(2)), stdmath.Pi/2), NewVec(T(minusTwo), T(4))},
+			{"project", vm.Project(NewVec(T(3), T(5)), NewVec(T(2), T(0))), NewVec(T(3), T(0))},
+			{"projectZeroAxis", vm.Project(NewVec(T(3), T(5)), Vec[T]{}), Vec[T]{}},
+			{"reflect", vm.Reflect(NewVec(T(3), T(5)), NewVec(T(0), T(1))), NewVec(T(3), T(minusFive))},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				if !approxEqualVec(c.got, c.expected) {
+					t.Errorf("expected %v, got %v", c.expected, c.got)
+				}
+			})
+		}
+	})
+}
+
+func approxEqualVec[T Numeric](a, b Vec[T]) bool {
+	return stdmath.Abs(float64(a.X)-float64(b.X)) <= eps &&
+		stdmath.Abs(float64(a.Y)-float64(b.Y)) <= eps
+}
+
+func TestVectorMath_Angle(t *testing.T) {
+	runAngleTest(t, "int", SignedIntVectorMath[int]{})
+	runAngleTest(t, "uint32", UnsignedIntVectorMath[uint32]{})
+	runAngleTest(t, "float64", FloatVectorMath[float64]{})
+}
+
+func runAngleTest[T Numeric](t *testing.T, name string, vm VectorMath[T]) {
+	t.Run(name, func(t *testing.T) {
+		x := NewVec(T(5), T(0))
+		y := NewVec(T(0), T(3))
+		if got := vm.Angle(x, y); stdmath.Abs(got-stdmath.Pi/2) > eps {
+			t.Errorf("expected π/2, got %v", got)
+		}
+		if got := vm.Angle(y, x); stdmath.Abs(got+stdmath.Pi/2) > eps {
+			t.Errorf("expected -π/2, got %v", got)
+		}
+	})
+}
+
+func TestVectorMath_WideIntermediates(t *testing.T) {
+	// iloczyny przekraczają zakres int32, wynik końcowy już nie
+	vm := SignedIntVectorMath[int32]{}
+	big := NewVec(int32(1<<20), int32(1<<20))
+	if got := vm.Project(big, NewVec(int32(1<<20), int32(0))); got != NewVec(int32(1<<20), int32(0)) {
+		t.Errorf("expected (%d,0), got %v", 1<<20, got)
+	}
+	if got := vm.Lerp(NewVec(int32(-2_000_000_000), 0), NewVec(int32(2_000_000_000), 0), 0.5); got != (Vec[int32]{}) {
+		t.Errorf("expected zero vector, got %v", got)
+	}
+}
+
+func TestVectorMath_CheckedAndSaturating(t *testing.T) {
+	t.Run("int32", func(t *testing.T) {
+		vm := SignedIntVectorMath[int32]{}
+		top := NewVec[int32](stdmath.MaxInt32, 0)
+		if _, ok := vm.CheckedAdd(top, NewVec[int32](1, 0)); ok {
+			t.Errorf("expected overflow past MaxInt32")
+		}
+		if _, ok := vm.CheckedSub(NewVec[int32](stdmath.MinInt32, 0), NewVec[int32](0, 1)); !ok {
+			t.Errorf("expected MinInt32-0 to stay in range")
+		}
+		if got, ok := vm.CheckedSub(NewVec[int32](5, 5), NewVec[int32](-2, 7)); !ok || got != NewVec[int32](7, -2) {
+			t.Errorf("expected (7,-2) ok, got %v %v", got, ok)
+		}
+		if got := vm.SaturatingAdd(top, NewVec[int32](5, -3)); got != NewVec[int32](stdmath.MaxInt32, -3) {
+			t.Errorf("unexpected saturating add %v", got)
+		}
+		if got := vm.SaturatingSub(NewVec[int32](stdmath.MinInt32, 0), NewVec[int32](1, -1)); got != NewVec[int32](stdmath.MinInt32, 1) {
+			t.Errorf("unexpected saturating sub %v", got)
+		}
+	})
+
+	t.Run("uint32", func(t *testing.T) {
+		vm := UnsignedIntVectorMath[uint32]{}
+		minusOne := int32(-1)
+		step := NewVec(uint32(minusOne), uint32(minusOne))
+		// krok o -1 zapisany w U2 jest poprawny, także poniżej zera
+		if got, ok := vm.CheckedAdd(NewVec[uint32](0, 3), step); !ok || got != NewVec(uint32(minusOne), 2) {
+			t.Errorf("expected (-1,2) ok, got %v %v", got, ok)
+		}
+		edge := NewVec[uint32](stdmath.MaxInt32, 0)
+		if _, ok := vm.CheckedAdd(edge, NewVec[uint32](1, 0)); ok {
+			t.Errorf("expected overflow past 2^31-1")
+		}
+		if _, ok := vm.CheckedSub(NewVec[uint32](0, 1<<31), NewVec[uint32](0, 1)); ok {
+			t.Errorf("expected underflow below -2^31")
+		}
+		if got := vm.SaturatingAdd(edge, NewVec[uint32](10, 10)); got != NewVec[uint32](stdmath.MaxInt32, 10) {
+			t.Errorf("unexpected saturating add %v", got)
+		}
+		if got := vm.SaturatingSub(NewVec[uint32](1<<31, 4), NewVec[uint32](1, 1)); got != NewVec[uint32](1<<31, 3) {
+			t.Errorf("unexpected saturating sub %v", got)
+		}
+		// nasycenie dotyczy zakresu ze znakiem: 3-5 to -2 w U2, a nie 0
+		minusTwo := int32(-2)
+		if got := vm.SaturatingSub(NewVec[uint32](3, 5), NewVec[uint32](5, 3)); got != NewVec(uint32(minusTwo), 2) {
+			t.Errorf("expected (-2,2) in two's complement, got %v", got)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		vm := FloatVectorMath[float64]{}
+		if _, ok := vm.CheckedAdd(NewVec(stdmath.MaxFloat64, 0), NewVec(stdmath.MaxFloat64, 0)); ok {
+			t.Errorf("expected overflow to +Inf")
+		}
+		if got, ok := vm.CheckedSub(NewVec(1.5, 2.0), NewVec(0.5, 3.0)); !ok || got != NewVec(1.0, -1.0) {
+			t.Errorf("expected (1,-1) ok, got %v %v", got, ok)
+		}
+	})
+}
+
+func TestVectorMath_UnsignedWidths(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
+		vm := VectorMathByType[uint8]()
+		// 0xF8 to -8 dla uint8, a nie 248
+		if got := vm.Clamp(NewVec[uint8](0xF8, 200), NewVec[uint8](100, 100)); got != NewVec[uint8](0, 0) {
+			t.Errorf("expected (0,0), got %v", got)
+		}
+		if got := vm.Wrap(NewVec[uint8](0xF8, 0x7F), NewVec[uint8](10, 10)); got != NewVec[uint8](2, 7) {
+			t.Errorf("expected (2,7), got %v", got)
+		}
+		if _, ok := vm.CheckedAdd(NewVec[uint8](120, 0), NewVec[uint8](8, 0)); ok {
+			t.Errorf("expected overflow past 127")
+		}
+		if got := vm.SaturatingSub(NewVec[uint8](0x81, 3), NewVec[uint8](5, 1)); got != NewVec[uint8](0x80, 2) {
+			t.Errorf("expected (-128,2), got %v", got)
+		}
+	})
+
+	t.Run("uint16", func(t *testing.T) {
+		vm := VectorMathByType[uint16]()
+		if got := vm.Wrap(NewVec[uint16](0xFFFF, 70000-65536), NewVec[uint16](1000, 1000)); got != NewVec[uint16](999, 464) {
+			t.Errorf("expected (999,464), got %v", got)
+		}
+		if got, ok := vm.CheckedAdd(NewVec[uint16](10, 10), NewVec[uint16](0xFFFF, 1)); !ok || got != NewVec[uint16](9, 11) {
+			t.Errorf("expected (9,11) ok, got %v %v", got, ok)
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		vm := VectorMathByType[uint64]()
+		big := uint64(1) << 40
+		if got := vm.Wrap(NewVec(big+5, ^uint64(0)), NewVec(big, big)); got != NewVec(uint64(5), big-1) {
+			t.Errorf("expected (5,2^40-1), got %v", got)
+		}
+		if got := vm.Clamp(NewVec(^uint64(0), big+1), NewVec(big, big)); got != NewVec(uint64(0), big) {
+			t.Errorf("expected (0,2^40), got %v", got)
+		}
+		if _, ok := vm.CheckedAdd(NewVec(uint64(stdmath.MaxInt64), 0), NewVec(uint64(1), 0)); ok {
+			t.Errorf("expected overflow past MaxInt64")
+		}
+		if got := vm.SaturatingAdd(NewVec(uint64(stdmath.MaxInt64), 0), NewVec(uint64(1), 1)); got != NewVec(uint64(stdmath.MaxInt64), 1) {
+			t.Errorf("unexpected saturating add %v", got)
+		}
+	})
+
+	t.Run("int16", func(t *testing.T) {
+		vm := VectorMathByType[int16]()
+		if got := vm.SaturatingAdd(NewVec[int16](stdmath.MaxInt16, -5), NewVec[int16](1, 1)); got != NewVec[int16](stdmath.MaxInt16, -4) {
+			t.Errorf("unexpected saturating add %v", got)
+		}
+	})
+}
+
+func TestVectorMath_ProductsSaturate(t *testing.T) {
+	i32 := SignedIntVectorMath[int32]{}
+	i64 := SignedIntVectorMath[int64]{}
+	u16 := UnsignedIntVectorMath[uint16]{}
+	u64 := UnsignedIntVectorMath[uint64]{}
+	minusThree, minusOne := int16(-3), int64(-1)
+	u16Min := uint16(1 << 15)
+
+	testCases := []struct {
+		name     string
+		got      any
+		expected any
+	}{
+		{name: "int32DotFits", got: i32.Dot(NewVec[int32](46340, 2), NewVec[int32](46340, -3)), expected: int32(2147395594)},
+		{name: "int32DotOverflows", got: i32.Dot(NewVec[int32](stdmath.MaxInt32, 0), NewVec[int32](2, 0)), expected: int32(stdmath.MaxInt32)},
+		{name: "int32CrossUnderflows", got: i32.Cross(NewVec[int32](stdmath.MinInt32, 0), NewVec[int32](0, 2)), expected: int32(stdmath.MinInt32)},
+		{name: "int32DistanceAcrossRange", got: i32.DistanceSquared(NewVec[int32](stdmath.MinInt32, 0), NewVec[int32](stdmath.MaxInt32, 0)), expected: int32(stdmath.MaxInt32)},
+		{name: "int64DotExact", got: i64.Dot(NewVec[int64](1<<31, -(1<<31)), NewVec[int64](1<<31, 1<<31)), expected: int64(0)},
+		{name: "int64DotBothMinimal", got: i64.Dot(NewVec[int64](stdmath.MinInt64, stdmath.MinInt64), NewVec[int64](stdmath.MinInt64, stdmath.MinInt64)), expected: int64(stdmath.MaxInt64)},
+		{name: "int64DotLargeMinusLarge", got: i64.Dot(NewVec[int64](1<<40, 1<<40), NewVec[int64](1<<40, -(1<<39))), expected: int64(stdmath.MaxInt64)},
+		{name: "int64CrossUnderflows", got: i64.Cross(NewVec[int64](stdmath.MinInt64, stdmath.MinInt64), NewVec[int64](stdmath.MinInt64, stdmath.MaxInt64)), expected: int64(stdmath.MinInt64)},
+		{name: "int64DistanceAcrossRange", got: i64.DistanceSquared(NewVec[int64](stdmath.MinInt64, 0), NewVec[int64](stdmath.MaxInt64, 0)), expected: int64(stdmath.MaxInt64)},
+		{name: "uint16DotNegativeFits", got: u16.Dot(NewVec(uint16(minusThree), 0), NewVec[uint16](5, 0)), expected: uint16(0xFFF1)},
+		{name: "uint16DotOverflows", got: u16.Dot(NewVec[uint16](300, 0), NewVec[uint16](300, 0)), expected: uint16(stdmath.MaxInt16)},
+		{name: "uint16CrossUnderflows", got: u16.Cross(NewVec[uint16](300, 0), NewVec(uint16(0), uint16(minusThree)*100)), expected: u16Min},
+		{name: "uint64DotOverflows", got: u64.Dot(NewVec[uint64](1<<62, 0), NewVec[uint64](4, 0)), expected: uint64(stdmath.MaxInt64)},
+		{name: "uint64DistanceOfMinusOne", got: u64.DistanceSquared(NewVec(uint64(minusOne), 0), NewVec[uint64](2, 0)), expected: uint64(9)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, tc.got)
+			}
+		})
+	}
+}
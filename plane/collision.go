@@ -0,0 +1,24 @@
+package plane
+
+import "github.com/kjkrol/gokg/geom"
+
+// Sweep reports the first contact of moving, translated by delta, with obstacle
+// (see geom.SweepAABB). In a toroidal space the sweep also crosses the seams,
+// for deltas of up to one world size per axis; other spaces sweep the plain
+// boxes.
+func Sweep[T geom.Numeric](space Space2D[T], moving AABB[T], delta geom.Vec[T], obstacle AABB[T]) (geom.SweepHit[T], bool) {
+	if torus, ok := space.(*toroidal2d[T]); ok {
+		return torus.sweep(moving, delta, obstacle)
+	}
+	return geom.SweepAABB(moving.AABB, delta, obstacle.AABB)
+}
+
+// Penetration returns the push that separates a from b (see
+// geom.PenetrationAABB). In a toroidal space the push takes the short way
+// across the seams; other spaces compare the plain boxes.
+func Penetration[T geom.Numeric](space Space2D[T], a, b AABB[T]) (geom.Penetration[T], bool) {
+	if torus, ok := space.(*toroidal2d[T]); ok {
+		return torus.penetration(a, b)
+	}
+	return geom.PenetrationAABB(a.AABB, b.AABB)
+}
@@ -1,5 +1,11 @@
 // Package plane defines 2D spaces (cartesian and torus) plus plane-aware boxes
 // and metrics. It wraps geometry primitives with boundary-aware behaviours,
-// handling clamping/wrapping, fragmentation across edges, translations, and
-// distance calculations reused by higher-level modules.
+// handling clamping/wrapping, fragmentation across edges, translations, swept
+// collisions, and distance calculations reused by higher-level modules. Spaces
+// built with WithStrictArithmetic report overflowing translations instead of
+// applying them, and WithMetric picks the Manhattan, Chebyshev or squared
+// Euclidean metric in place of the Euclidean default. Sweep, Penetration and
+// MetricOf take a Space2D and answer swept collision, overlap depth and metric
+// queries in that space. GridCells walks the grid cells of a line and, on a
+// torus, keeps walking across the seams.
 package plane
@@ -3,14 +3,9 @@ package plane
 import "github.com/kjkrol/gokg/geom"
 
 // NewEuclidean2D constructs a 2D space that clamps vectors to the given width and height.
-func NewEuclidean2D[T geom.Numeric](sizeX, sizeY T) Space2D[T] {
-	return &euclidean2d[T]{
-		space2d: space2d[T]{
-			size:       geom.NewVec(sizeX, sizeY),
-			vectorMath: geom.VectorMathByType[T](),
-			viewport:   geom.NewAABBAt(geom.NewVec[T](0, 0), sizeX, sizeY),
-		},
-	}
+// Options such as WithStrictArithmetic tune how it handles arithmetic overflow.
+func NewEuclidean2D[T geom.Numeric](sizeX, sizeY T, opts ...Option) Space2D[T] {
+	return &euclidean2d[T]{space2d: newSpace2d(sizeX, sizeY, opts)}
 }
 
 type euclidean2d[T geom.Numeric] struct{ space2d[T] }
@@ -39,14 +34,15 @@ func (s euclidean2d[T]) WrapVec(vec geom.Vec[T]) AABB[T] {
 }
 
 func (s euclidean2d[T]) Expand(aabb *AABB[T], margin T) {
-	aabb.TopLeft.AddMutable(geom.NewVec(-margin, -margin))
-	aabb.Size.AddMutable(geom.NewVec(2*margin, 2*margin))
-	s.normalizeAABB(aabb)
+	if s.expand(aabb, margin) {
+		s.normalizeAABB(aabb)
+	}
 }
 
 func (s euclidean2d[T]) Translate(aabb *AABB[T], delta geom.Vec[T]) {
-	aabb.TopLeft.AddMutable(delta)
-	s.normalizeAABB(aabb)
+	if s.translate(aabb, delta) {
+		s.normalizeAABB(aabb)
+	}
 }
 
 func (s euclidean2d[T]) AABBDistance() AABBDistance[T] {
@@ -85,5 +81,5 @@ func (s euclidean2d[T]) metric(vec1, vec2 geom.Vec[T]) T {
 		dy = dy - vec2.Y
 	}
 	delta := geom.NewVec(dx, dy)
-	return s.vectorMath.Length(s.vectorMath.Clamp(delta, s.size))
+	return s.norm(s.vectorMath.Clamp(delta, s.size))
 }
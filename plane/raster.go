@@ -0,0 +1,18 @@
+package plane
+
+import (
+	"iter"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+// GridCells yields the cells of the line from from to to with the given
+// traversal (see geom.GridCells). In a toroidal space the line takes the short
+// way across the seams and every cell is wrapped into the space, so line of
+// sight works across the edges of the map; other spaces walk the plain grid.
+func GridCells[T geom.Numeric](space Space2D[T], from, to geom.Vec[T], traversal geom.GridTraversal) iter.Seq[geom.Vec[uint32]] {
+	if torus, ok := space.(*toroidal2d[T]); ok {
+		return geom.GridCellsWrapped(from, to, torus.size, traversal)
+	}
+	return geom.GridCells(from, to, traversal)
+}
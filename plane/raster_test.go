@@ -0,0 +1,38 @@
+package plane
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestGridCells(t *testing.T) {
+	runGridCellsTest[int](t, "int")
+	runGridCellsTest[uint32](t, "uint32")
+	runGridCellsTest[float64](t, "float64")
+}
+
+func runGridCellsTest[T geom.Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		cell := geom.NewVec[uint32]
+		testCases := []struct {
+			name     string
+			space    Space2D[T]
+			expected []geom.Vec[uint32]
+		}{
+			{name: "euclideanStraight", space: NewEuclidean2D[T](10, 10),
+				expected: []geom.Vec[uint32]{cell(1, 5), cell(2, 5), cell(3, 5), cell(4, 5), cell(5, 5), cell(6, 5), cell(7, 5), cell(8, 5)}},
+			{name: "toroidalCrossesSeam", space: NewToroidal2D[T](10, 10),
+				expected: []geom.Vec[uint32]{cell(1, 5), cell(0, 5), cell(9, 5), cell(8, 5)}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got := slices.Collect(GridCells(tc.space, vec[T](1, 5), vec[T](8, 5), geom.GridBresenham))
+				if !slices.Equal(got, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, got)
+				}
+			})
+		}
+	})
+}
@@ -1,6 +1,11 @@
 package plane
 
-import "github.com/kjkrol/gokg/geom"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kjkrol/gokg/geom"
+)
 
 const (
 	modeEuclidean2D = "Euclidean2D"
@@ -22,8 +27,136 @@ type (
 	Metric[T geom.Numeric] func(vec1, vec2 geom.Vec[T]) T
 )
 
+// ErrOutOfRange is reported by strict spaces when Translate or Expand would
+// overflow the coordinate type; see WithStrictArithmetic.
+var ErrOutOfRange = errors.New("plane: arithmetic out of range")
+
+// MetricKind selects how a space measures distances; see WithMetric.
+type MetricKind int
+
+const (
+	// MetricEuclidean is the straight-line distance, rounded up for integer
+	// types. It is the default.
+	MetricEuclidean MetricKind = iota
+	// MetricSquaredEuclidean is the squared straight-line distance, which
+	// orders like MetricEuclidean without a square root.
+	MetricSquaredEuclidean
+	// MetricManhattan counts orthogonal grid steps.
+	MetricManhattan
+	// MetricChebyshev counts grid steps when diagonal moves cost the same as
+	// orthogonal ones.
+	MetricChebyshev
+)
+
+// Option configures a space built by NewEuclidean2D or NewToroidal2D.
+type Option func(*options)
+
+type options struct {
+	strict   bool
+	onReport func(error)
+	metric   MetricKind
+}
+
+// WithStrictArithmetic makes Translate and Expand check their arithmetic with
+// geom.Vec.CheckedAdd and CheckedSub. A step that would overflow leaves the box
+// untouched and passes an error wrapping ErrOutOfRange to report; a nil report
+// panics with that error instead. Clamping and wrapping at the space boundary
+// are unaffected.
+func WithStrictArithmetic(report func(error)) Option {
+	return func(o *options) {
+		o.strict = true
+		o.onReport = report
+	}
+}
+
+// WithMetric makes MetricOf and AABBDistance measure with kind instead of the
+// Euclidean distance.
+func WithMetric(kind MetricKind) Option {
+	return func(o *options) { o.metric = kind }
+}
+
+// MetricOf returns the distance between two points in space under the metric
+// chosen with WithMetric, taking the short way across seams on a torus. Spaces
+// from outside this package get the plain Euclidean distance.
+func MetricOf[T geom.Numeric](space Space2D[T]) Metric[T] {
+	if s, ok := space.(metricSpace[T]); ok {
+		return s.metric
+	}
+	return func(vec1, vec2 geom.Vec[T]) T { return geom.PointDistance(vec1, vec2, geom.Euclidean[T]) }
+}
+
+// metricSpace is implemented by the spaces of this package.
+type metricSpace[T geom.Numeric] interface {
+	metric(vec1, vec2 geom.Vec[T]) T
+}
+
 type space2d[T geom.Numeric] struct {
 	size       geom.Vec[T]
 	vectorMath geom.VectorMath[T]
 	viewport   geom.AABB[T]
+	norm       geom.DistanceMetric[T]
+	options
+}
+
+func newSpace2d[T geom.Numeric](sizeX, sizeY T, opts []Option) space2d[T] {
+	s := space2d[T]{
+		size:       geom.NewVec(sizeX, sizeY),
+		vectorMath: geom.VectorMathByType[T](),
+		viewport:   geom.NewAABBAt(geom.NewVec[T](0, 0), sizeX, sizeY),
+	}
+	for _, opt := range opts {
+		opt(&s.options)
+	}
+	switch s.metric {
+	case MetricSquaredEuclidean:
+		s.norm = func(gap geom.Vec[T]) T { return s.vectorMath.Dot(gap, gap) }
+	case MetricManhattan:
+		s.norm = geom.Manhattan[T]
+	case MetricChebyshev:
+		s.norm = geom.Chebyshev[T]
+	default:
+		s.norm = s.vectorMath.Length
+	}
+	return s
+}
+
+// translate moves aabb.TopLeft by delta and reports whether the move was applied.
+func (s space2d[T]) translate(aabb *AABB[T], delta geom.Vec[T]) bool {
+	if !s.strict {
+		aabb.TopLeft.AddMutable(delta)
+		return true
+	}
+	topLeft, ok := s.vectorMath.CheckedAdd(aabb.TopLeft, delta)
+	if !ok {
+		s.report(fmt.Errorf("%w: translate %v by %v", ErrOutOfRange, aabb.TopLeft, delta))
+		return false
+	}
+	aabb.TopLeft = topLeft
+	return true
+}
+
+// expand grows aabb by margin on every side and reports whether it was applied.
+func (s space2d[T]) expand(aabb *AABB[T], margin T) bool {
+	m := geom.NewVec(margin, margin)
+	if !s.strict {
+		aabb.TopLeft.AddMutable(geom.NewVec(-margin, -margin))
+		aabb.Size.AddMutable(m.Add(m))
+		return true
+	}
+	twice, okTwice := s.vectorMath.CheckedAdd(m, m)
+	topLeft, okTopLeft := s.vectorMath.CheckedSub(aabb.TopLeft, m)
+	size, okSize := s.vectorMath.CheckedAdd(aabb.Size, twice)
+	if !okTwice || !okTopLeft || !okSize {
+		s.report(fmt.Errorf("%w: expand %v by %v", ErrOutOfRange, aabb.AABB, margin))
+		return false
+	}
+	aabb.TopLeft, aabb.Size = topLeft, size
+	return true
+}
+
+func (s space2d[T]) report(err error) {
+	if s.onReport == nil {
+		panic(err)
+	}
+	s.onReport(err)
 }
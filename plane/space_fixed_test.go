@@ -0,0 +1,41 @@
+package plane
+
+import (
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func fixedVec(x, y float64) geom.Vec[geom.Fixed] {
+	return geom.NewVec(geom.FixedFromFloat64(x), geom.FixedFromFloat64(y))
+}
+
+func TestToroidal2D_Fixed(t *testing.T) {
+	toroidal := NewToroidal2D(geom.FixedFromInt(10), geom.FixedFromInt(10))
+	aabb := NewAABB(fixedVec(8.5, 4), geom.FixedFromInt(2), geom.FixedFromFloat64(1.5))
+
+	toroidal.Translate(&aabb, fixedVec(0.25, -4.5))
+
+	expectAABBState(t, aabb, fixedVec(8.75, 9.5), fixedVec(10, 10), map[FragPosition][2]geom.Vec[geom.Fixed]{
+		FRAG_RIGHT:        {fixedVec(0, 9.5), fixedVec(0.75, 10)},
+		FRAG_BOTTOM:       {fixedVec(8.75, 0), fixedVec(10, 1)},
+		FRAG_BOTTOM_RIGHT: {fixedVec(0, 0), fixedVec(0.75, 1)},
+	})
+
+	// najkrótsza droga prowadzi przez szew: (0.5, 0) – (9.5, 0) to 1 jednostka
+	if d := toroidal.(*toroidal2d[geom.Fixed]).metric(fixedVec(0.5, 0), fixedVec(9.5, 0)); d != geom.FixedOne {
+		t.Errorf("expected distance 1 across the seam, got %v", d)
+	}
+}
+
+func TestEuclidean2D_Fixed(t *testing.T) {
+	euclidean := NewEuclidean2D(geom.FixedFromInt(10), geom.FixedFromInt(10))
+	aabb := NewAABB(fixedVec(1.5, 1.5), geom.FixedFromInt(2), geom.FixedFromInt(2))
+
+	euclidean.Translate(&aabb, fixedVec(-3, 7.25))
+	expectAABBState(t, aabb, fixedVec(0, 8.75), fixedVec(0.5, 10), map[FragPosition][2]geom.Vec[geom.Fixed]{})
+
+	if d := euclidean.(*euclidean2d[geom.Fixed]).metric(fixedVec(0, 0), fixedVec(3, 4)); d != geom.FixedFromInt(5) {
+		t.Errorf("expected distance 5, got %v", d)
+	}
+}
@@ -0,0 +1,27 @@
+package plane
+
+import (
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+// foreignSpace stands in for a Space2D implemented outside this package: only
+// the exported methods of the wrapped space are promoted.
+type foreignSpace[T geom.Numeric] struct{ Space2D[T] }
+
+func TestSpace2D_ForeignImplementationFallsBack(t *testing.T) {
+	var space Space2D[int] = foreignSpace[int]{NewToroidal2D(10, 10, WithMetric(MetricChebyshev))}
+
+	// zamiast skrótu przez szew i metryki Czebyszewa dostajemy zwykłą odległość euklidesową
+	if got := MetricOf(space)(vec[int](1, 1), vec[int](9, 7)); got != 10 {
+		t.Errorf("expected Euclidean 10, got %d", got)
+	}
+	a, b := NewAABB(vec[int](0, 4), 2, 2), NewAABB(vec[int](8, 4), 2, 2)
+	if _, ok := Penetration(space, a, b); ok {
+		t.Errorf("expected no overlap without seams")
+	}
+	if hit, ok := Sweep(space, a, vec[int](10, 0), b); !ok || hit.Time != 0.6 {
+		t.Errorf("expected a plain sweep hit at t=0.6, got %v %v", hit, ok)
+	}
+}
@@ -0,0 +1,49 @@
+package plane
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestSpace2D_WithMetric(t *testing.T) {
+	runSpaceWithMetricTest[int](t, "int")
+	runSpaceWithMetricTest[uint32](t, "uint32")
+	runSpaceWithMetricTest[float64](t, "float64")
+}
+
+func runSpaceWithMetricTest[T geom.Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		// w torusie 10x10 najkrótsza luka między (1,1) a (9,8) to (2,3), w przestrzeni euklidesowej (8,7)
+		testCases := []struct {
+			kind      MetricKind
+			euclidean T
+			toroidal  T
+		}{
+			{kind: MetricEuclidean, euclidean: 11, toroidal: 4},
+			{kind: MetricSquaredEuclidean, euclidean: 113, toroidal: 13},
+			{kind: MetricManhattan, euclidean: 15, toroidal: 5},
+			{kind: MetricChebyshev, euclidean: 8, toroidal: 3},
+		}
+		for _, tc := range testCases {
+			euclidean := NewEuclidean2D(T(10), T(10), WithMetric(tc.kind))
+			toroidal := NewToroidal2D(T(10), T(10), WithMetric(tc.kind))
+
+			// dla float64 odległość euklidesowa nie jest zaokrąglana, więc porównujemy sufit
+			if got := MetricOf(euclidean)(vec[T](1, 1), vec[T](9, 8)); math.Ceil(float64(got)) != float64(tc.euclidean) {
+				t.Errorf("kind %d euclidean: expected %v, got %v", tc.kind, tc.euclidean, got)
+			}
+			if got := MetricOf(toroidal)(vec[T](1, 1), vec[T](9, 8)); math.Ceil(float64(got)) != float64(tc.toroidal) {
+				t.Errorf("kind %d toroidal: expected %v, got %v", tc.kind, tc.toroidal, got)
+			}
+		}
+
+		chebyshev := NewToroidal2D(T(10), T(10), WithMetric(MetricChebyshev))
+		a := NewAABB(vec[T](0, 0), T(2), T(2))
+		b := NewAABB(vec[T](5, 3), T(2), T(2))
+		if got := chebyshev.AABBDistance()(a.AABB, b.AABB); got != 3 {
+			t.Errorf("AABBDistance: expected Chebyshev gap 3, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package plane
+
+import (
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestSpace2DPenetration(t *testing.T) {
+	runSpace2DPenetrationTest[int](t, "int")
+	runSpace2DPenetrationTest[uint32](t, "uint32")
+	runSpace2DPenetrationTest[float64](t, "float64")
+}
+
+func runSpace2DPenetrationTest[T geom.Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		minusTwo := int32(-2)
+
+		t.Run("EuclideanPushesInside", func(t *testing.T) {
+			space := NewEuclidean2D(T(100), T(100))
+			a := NewAABB(vec[T](96, 10), T(4), T(4))
+			b := NewAABB(vec[T](1, 8), T(96), T(10))
+			p, ok := Penetration(space, a, b)
+			if !ok || p.MTV != vec[T](1, 0) {
+				t.Errorf("expected push by (1,0), got %+v %v", p, ok)
+			}
+		})
+
+		t.Run("ToroidalPicksShortWayAcrossSeam", func(t *testing.T) {
+			space := NewToroidal2D(T(100), T(100))
+			a := NewAABB(vec[T](96, 10), T(6), T(4))
+			b := NewAABB(vec[T](0, 8), T(50), T(10))
+			space.Translate(&a, vec[T](0, 0))
+			space.Translate(&b, vec[T](0, 0))
+
+			p, ok := Penetration(space, a, b)
+			if !ok || p.MTV != geom.NewVec(T(minusTwo), 0) || p.Depth != vec[T](2, 6) {
+				t.Errorf("expected push by (-2,0) across the seam, got %+v %v", p, ok)
+			}
+
+			space.Translate(&a, p.MTV)
+			if _, ok := Penetration(space, a, b); ok {
+				t.Errorf("expected %v to be separated from %v", a, b)
+			}
+		})
+
+		t.Run("ToroidalNoOverlap", func(t *testing.T) {
+			space := NewToroidal2D(T(100), T(100))
+			a := NewAABB(vec[T](40, 40), T(4), T(4))
+			b := NewAABB(vec[T](0, 0), T(10), T(10))
+			if p, ok := Penetration(space, a, b); ok {
+				t.Errorf("expected no overlap, got %+v", p)
+			}
+		})
+	})
+}
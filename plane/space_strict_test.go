@@ -0,0 +1,76 @@
+package plane
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestSpace2D_StrictArithmetic(t *testing.T) {
+	constructors := map[string]func(sizeX, sizeY uint32, opts ...Option) Space2D[uint32]{
+		"euclidean": NewEuclidean2D[uint32],
+		"toroidal":  NewToroidal2D[uint32],
+	}
+	for name, newSpace := range constructors {
+		t.Run(name, func(t *testing.T) {
+			var reported []error
+			space := newSpace(10, 10, WithStrictArithmetic(func(err error) { reported = append(reported, err) }))
+
+			t.Run("InRangeStepApplies", func(t *testing.T) {
+				aabb := NewAABB(vec[uint32](4, 4), 2, 2)
+				minusOne := int32(-1)
+				space.Translate(&aabb, geom.NewVec(uint32(minusOne), 1))
+				if aabb.TopLeft != vec[uint32](3, 5) || len(reported) != 0 {
+					t.Errorf("expected (3,5) without reports, got %v %v", aabb.TopLeft, reported)
+				}
+			})
+
+			t.Run("OverflowingTranslateIsReported", func(t *testing.T) {
+				reported = nil
+				aabb := NewAABB(vec[uint32](4, 4), 2, 2)
+				space.Translate(&aabb, geom.NewVec[uint32](math.MaxInt32, 0))
+				if aabb.TopLeft != vec[uint32](4, 4) {
+					t.Errorf("expected box to stay at (4,4), got %v", aabb.TopLeft)
+				}
+				if len(reported) != 1 || !errors.Is(reported[0], ErrOutOfRange) {
+					t.Errorf("expected one ErrOutOfRange report, got %v", reported)
+				}
+			})
+
+			t.Run("OverflowingExpandIsReported", func(t *testing.T) {
+				reported = nil
+				aabb := NewAABB(vec[uint32](4, 4), 2, 2)
+				space.Expand(&aabb, 1<<30)
+				if aabb.Size != vec[uint32](2, 2) {
+					t.Errorf("expected size to stay (2,2), got %v", aabb.Size)
+				}
+				if len(reported) != 1 || !errors.Is(reported[0], ErrOutOfRange) {
+					t.Errorf("expected one ErrOutOfRange report, got %v", reported)
+				}
+			})
+		})
+	}
+}
+
+func TestSpace2D_StrictArithmeticPanicsWithoutReporter(t *testing.T) {
+	space := NewEuclidean2D[int32](10, 10, WithStrictArithmetic(nil))
+	aabb := NewAABB(vec[int32](4, 4), 2, 2)
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("expected ErrOutOfRange panic, got %v", err)
+		}
+	}()
+	space.Translate(&aabb, geom.NewVec[int32](math.MaxInt32, 0))
+}
+
+func TestSpace2D_LenientArithmeticWraps(t *testing.T) {
+	space := NewToroidal2D[uint32](10, 10)
+	aabb := NewAABB(vec[uint32](4, 4), 2, 2)
+	space.Translate(&aabb, geom.NewVec[uint32](math.MaxInt32, 0))
+	if aabb.TopLeft == vec[uint32](4, 4) {
+		t.Errorf("expected lenient space to apply the step")
+	}
+}
@@ -0,0 +1,62 @@
+package plane
+
+import (
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestSpace2DSweep(t *testing.T) {
+	runSpace2DSweepTest[int](t, "int")
+	runSpace2DSweepTest[uint32](t, "uint32")
+	runSpace2DSweepTest[float64](t, "float64")
+}
+
+func runSpace2DSweepTest[T geom.Numeric](t *testing.T, name string) {
+	t.Run(name, func(t *testing.T) {
+		minusOne, minusFour := int32(-1), int32(-4)
+		neg := T(minusOne)
+
+		t.Run("EuclideanStopsAtWall", func(t *testing.T) {
+			space := NewEuclidean2D(T(100), T(100))
+			moving := NewAABB(vec[T](0, 5), T(2), T(2))
+			wall := NewAABB(vec[T](50, 0), T(1), T(100))
+			hit, ok := Sweep(space, moving, vec[T](80, 0), wall)
+			if !ok || hit.Travel != vec[T](48, 0) || hit.Normal != geom.NewVec(neg, 0) {
+				t.Errorf("expected hit after 48 units, got %+v %v", hit, ok)
+			}
+		})
+
+		t.Run("EuclideanDoesNotWrap", func(t *testing.T) {
+			space := NewEuclidean2D(T(100), T(100))
+			moving := NewAABB(vec[T](95, 5), T(2), T(2))
+			obstacle := NewAABB(vec[T](2, 0), T(2), T(20))
+			if hit, ok := Sweep(space, moving, vec[T](10, 0), obstacle); ok {
+				t.Errorf("expected no hit across the edge, got %+v", hit)
+			}
+		})
+
+		t.Run("ToroidalSweepsAcrossSeam", func(t *testing.T) {
+			space := NewToroidal2D(T(100), T(100))
+			moving := NewAABB(vec[T](95, 5), T(2), T(2))
+			obstacle := NewAABB(vec[T](2, 0), T(2), T(20))
+			space.Translate(&moving, vec[T](0, 0))
+			space.Translate(&obstacle, vec[T](0, 0))
+
+			hit, ok := Sweep(space, moving, vec[T](10, 0), obstacle)
+			if !ok || hit.Travel != vec[T](5, 0) || hit.Normal != geom.NewVec(neg, 0) {
+				t.Errorf("expected hit after 5 units across the seam, got %+v %v", hit, ok)
+			}
+		})
+
+		t.Run("ToroidalSweepsBackAcrossSeam", func(t *testing.T) {
+			space := NewToroidal2D(T(100), T(100))
+			moving := NewAABB(vec[T](2, 50), T(2), T(2))
+			obstacle := NewAABB(vec[T](90, 40), T(8), T(20))
+			hit, ok := Sweep(space, moving, geom.NewVec(T(minusFour)*2, 0), obstacle)
+			if !ok || hit.Travel != geom.NewVec(T(minusFour), 0) || hit.Normal != vec[T](1, 0) {
+				t.Errorf("expected hit after -4 units across the seam, got %+v %v", hit, ok)
+			}
+		})
+	})
+}
@@ -9,6 +9,10 @@ import (
 func TestToroidal2DTranslate(t *testing.T) {
 	runToroidal2DTranslateTest[int](t, "int")
 	runToroidal2DTranslateTest[uint32](t, "uint32")
+	runToroidal2DTranslateTest[int16](t, "int16")
+	runToroidal2DTranslateTest[uint8](t, "uint8")
+	runToroidal2DTranslateTest[uint16](t, "uint16")
+	runToroidal2DTranslateTest[uint64](t, "uint64")
 	runToroidal2DTranslateTest[float64](t, "float64")
 }
 
@@ -122,6 +126,10 @@ func runToroidal2DTranslateTest[T geom.Numeric](t *testing.T, name string) {
 func TestEuclidean2DTranslate(t *testing.T) {
 	runEuclidean2DTranslateTest[int](t, "int")
 	runEuclidean2DTranslateTest[uint32](t, "uint32")
+	runEuclidean2DTranslateTest[int16](t, "int16")
+	runEuclidean2DTranslateTest[uint8](t, "uint8")
+	runEuclidean2DTranslateTest[uint16](t, "uint16")
+	runEuclidean2DTranslateTest[uint64](t, "uint64")
 	runEuclidean2DTranslateTest[float64](t, "float64")
 }
 
@@ -0,0 +1,63 @@
+package plane
+
+import (
+	"testing"
+
+	"github.com/kjkrol/gokg/geom"
+)
+
+func TestSpace2D_UnsignedSizeLimit(t *testing.T) {
+	runSpace2DSizeLimitTest[uint8](t, "uint8", 63)
+	runSpace2DSizeLimitTest[uint16](t, "uint16", 16383)
+}
+
+// runSpace2DSizeLimitTest moves boxes across the edges of the largest space T
+// that leaves room for them in the signed range and expects the same result as
+// in an int space of that size.
+func runSpace2DSizeLimitTest[T geom.Numeric](t *testing.T, name string, limit int) {
+	t.Run(name, func(t *testing.T) {
+		constructors := map[string][2]func() any{
+			"euclidean": {
+				func() any { return NewEuclidean2D(T(limit), T(limit)) },
+				func() any { return NewEuclidean2D(limit, limit) },
+			},
+			"toroidal": {
+				func() any { return NewToroidal2D(T(limit), T(limit)) },
+				func() any { return NewToroidal2D(limit, limit) },
+			},
+		}
+		testCases := []struct {
+			name       string
+			x, y, size int
+			deltaX     int
+			deltaY     int
+		}{
+			{name: "leftOfOrigin", x: 0, y: 0, size: 2, deltaX: -1, deltaY: -1},
+			{name: "pastFarEdge", x: limit - 1, y: limit - 1, size: 1, deltaX: 1, deltaY: 1},
+			{name: "farEdgeToOrigin", x: limit - 2, y: 3, size: 2, deltaX: 2, deltaY: -3},
+			{name: "alongFarEdge", x: limit - 1, y: 0, size: 1, deltaX: 0, deltaY: limit / 2},
+			{name: "wideBoxAcrossFarEdge", x: limit, y: limit, size: limit / 2, deltaX: limit / 2, deltaY: -limit / 2},
+		}
+		for spaceName, newSpaces := range constructors {
+			for _, tc := range testCases {
+				t.Run(spaceName+"/"+tc.name, func(t *testing.T) {
+					space := newSpaces[0]().(Space2D[T])
+					reference := newSpaces[1]().(Space2D[int])
+
+					aabb := NewAABB(vec[T](tc.x, tc.y), T(tc.size), T(tc.size))
+					space.Translate(&aabb, vec[T](tc.deltaX, tc.deltaY))
+					want := NewAABB(vec[int](tc.x, tc.y), tc.size, tc.size)
+					reference.Translate(&want, vec[int](tc.deltaX, tc.deltaY))
+
+					got := [2]geom.Vec[int]{
+						{X: int(aabb.TopLeft.X), Y: int(aabb.TopLeft.Y)},
+						{X: int(aabb.BottomRight.X), Y: int(aabb.BottomRight.Y)},
+					}
+					if got != [2]geom.Vec[int]{want.TopLeft, want.BottomRight} {
+						t.Errorf("expected %v, got %v", want.AABB, aabb.AABB)
+					}
+				})
+			}
+		}
+	})
+}
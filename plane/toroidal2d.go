@@ -3,14 +3,9 @@ package plane
 import "github.com/kjkrol/gokg/geom"
 
 // NewToroidal2D constructs a 2D space with wrap-around behaviour on both axes.
-func NewToroidal2D[T geom.Numeric](sizeX, sizeY T) Space2D[T] {
-	return &toroidal2d[T]{
-		space2d: space2d[T]{
-			size:       geom.NewVec(sizeX, sizeY),
-			vectorMath: geom.VectorMathByType[T](),
-			viewport:   geom.NewAABBAt(geom.NewVec[T](0, 0), sizeX, sizeY),
-		},
-	}
+// Options such as WithStrictArithmetic tune how it handles arithmetic overflow.
+func NewToroidal2D[T geom.Numeric](sizeX, sizeY T, opts ...Option) Space2D[T] {
+	return &toroidal2d[T]{space2d: newSpace2d(sizeX, sizeY, opts)}
 }
 
 type toroidal2d[T geom.Numeric] struct{ space2d[T] }
@@ -39,14 +34,53 @@ func (s toroidal2d[T]) WrapVec(vec geom.Vec[T]) AABB[T] {
 }
 
 func (s toroidal2d[T]) Expand(aabb *AABB[T], margin T) {
-	aabb.TopLeft.AddMutable(geom.NewVec(-margin, -margin))
-	aabb.Size.AddMutable(geom.NewVec(2*margin, 2*margin))
-	s.normalizeAABB(aabb)
+	if s.expand(aabb, margin) {
+		s.normalizeAABB(aabb)
+	}
 }
 
 func (s toroidal2d[T]) Translate(aabb *AABB[T], delta geom.Vec[T]) {
-	aabb.TopLeft.AddMutable(delta)
-	s.normalizeAABB(aabb)
+	if s.translate(aabb, delta) {
+		s.normalizeAABB(aabb)
+	}
+}
+
+// sweep tests the moving box, unwrapped from its fragments, against the copies
+// of obstacle in the neighbouring tiles of the torus and keeps the earliest hit.
+// It covers deltas of up to one world size per axis; split longer moves.
+func (s toroidal2d[T]) sweep(moving AABB[T], delta geom.Vec[T], obstacle AABB[T]) (geom.SweepHit[T], bool) {
+	box := geom.NewAABBAt(moving.TopLeft, moving.Size.X, moving.Size.Y)
+	var best geom.SweepHit[T]
+	found := false
+	for ky := -2; ky <= 2; ky++ {
+		for kx := -2; kx <= 2; kx++ {
+			pos := geom.NewVec(shiftBy(obstacle.TopLeft.X, kx, s.size.X), shiftBy(obstacle.TopLeft.Y, ky, s.size.Y))
+			image := geom.NewAABBAt(pos, obstacle.Size.X, obstacle.Size.Y)
+			if hit, ok := geom.SweepAABB(box, delta, image); ok && (!found || hit.Time < best.Time) {
+				best, found = hit, true
+			}
+		}
+	}
+	return best, found
+}
+
+// penetration compares the unwrapped a with the copies of b in the neighbouring
+// tiles and returns the overlap with the shortest push.
+func (s toroidal2d[T]) penetration(a, b AABB[T]) (geom.Penetration[T], bool) {
+	box := geom.NewAABBAt(a.TopLeft, a.Size.X, a.Size.Y)
+	var best geom.Penetration[T]
+	found := false
+	for ky := -1; ky <= 1; ky++ {
+		for kx := -1; kx <= 1; kx++ {
+			pos := geom.NewVec(shiftBy(b.TopLeft.X, kx, s.size.X), shiftBy(b.TopLeft.Y, ky, s.size.Y))
+			image := geom.NewAABBAt(pos, b.Size.X, b.Size.Y)
+			p, ok := geom.PenetrationAABB(box, image)
+			if ok && (!found || min(p.Depth.X, p.Depth.Y) < min(best.Depth.X, best.Depth.Y)) {
+				best, found = p, true
+			}
+		}
+	}
+	return best, found
 }
 
 func (s toroidal2d[T]) AABBDistance() AABBDistance[T] {
@@ -110,5 +144,17 @@ func (s toroidal2d[T]) metric(vec1, vec2 geom.Vec[T]) T {
 		}
 	}
 
-	return s.vectorMath.Length(delta)
+	return s.norm(delta)
+}
+
+// shiftBy moves v by k world sizes; negative shifts of unsigned values wrap into
+// two's complement, which geom reads back as negative coordinates.
+func shiftBy[T geom.Numeric](v T, k int, size T) T {
+	for ; k > 0; k-- {
+		v += size
+	}
+	for ; k < 0; k++ {
+		v -= size
+	}
+	return v
 }